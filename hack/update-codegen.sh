@@ -20,6 +20,17 @@ set -o pipefail
 
 SCRIPT_ROOT=$(dirname "${BASH_SOURCE[0]}")/..
 
+# apply configurations of the types referenced from apimachinery and api are provided by client-go
+META_V1=k8s.io/apimachinery/pkg/apis/meta/v1
+APPLY=k8s.io/client-go/applyconfigurations
+EXTERNAL_APPLYCONFIGURATIONS="${META_V1}.TypeMeta:${APPLY}/meta/v1,${META_V1}.ObjectMeta:${APPLY}/meta/v1"
+EXTERNAL_APPLYCONFIGURATIONS+=",${META_V1}.OwnerReference:${APPLY}/meta/v1,${META_V1}.ManagedFieldsEntry:${APPLY}/meta/v1"
+EXTERNAL_APPLYCONFIGURATIONS+=",k8s.io/api/core/v1.Taint:${APPLY}/core/v1"
+for TYPE in CrossVersionObjectReference HorizontalPodAutoscalerBehavior MetricSpec MetricStatus; do
+  EXTERNAL_APPLYCONFIGURATIONS+=",k8s.io/api/autoscaling/v2.${TYPE}:${APPLY}/autoscaling/v2"
+done
+export EXTERNAL_APPLYCONFIGURATIONS
+
 # generate the code with:
 # --output-base    because this script should also be able to run inside the vendor dir of
 #                  k8s.io/kubernetes. The output-base is needed for the generators to output into the vendor dir
@@ -106,6 +106,8 @@ type CustomMetricSpec struct {
 
 	// Query defines the metrics query statement.
 	// There are preset templates for resource portraits, so the query statement can be empty.
+	// Queries in the form of a bare metric name are validated against the external metrics
+	// registry, and the others are passed to the metrics backend as they are.
 	Query string `json:"query,omitempty"`
 
 	// Value represents the threshold, corresponding to the AverageValue in HPA.
@@ -25,10 +25,11 @@ import (
 )
 
 func init() {
-	// We only register manually written functions here. The registration of the
-	// generated functions takes place in the generated files. The separation
-	// makes the code compile even when the generated files are missing.
-	workloadapi.SchemeBuilder.Register(addSPDKnownTypes)
+	// register indicators of the extended indicators in spd, which are configured
+	// by the config group but decoded by the workload group.
+	workloadapi.MustRegisterExtendedIndicators("TransparentMemoryOffloading", &TransparentMemoryOffloadingIndicators{})
+	workloadapi.MustRegisterExtendedIndicators("ResourcePortrait", &ResourcePortraitIndicators{})
+	workloadapi.MustRegisterExtendedIndicators("ReclaimResource", &ReclaimResourceIndicators{})
 }
 
 const (
@@ -65,14 +66,6 @@ var (
 	AddToScheme = SchemeBuilder.AddToScheme
 )
 
-func addSPDKnownTypes(scheme *runtime.Scheme) error {
-	scheme.AddKnownTypes(workloadapi.SchemeGroupVersion,
-		&TransparentMemoryOffloadingIndicators{},
-		&ResourcePortraitIndicators{},
-		&ReclaimResourceIndicators{})
-	return nil
-}
-
 // Adds the list of known types to the given scheme.
 func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
@@ -0,0 +1,274 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topology
+
+import (
+	"fmt"
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/kubewharf/katalyst-api/pkg/apis/node/v1alpha1"
+)
+
+// SumAllocations returns the total requests of all the allocations in the zone itself,
+// and allocations in its children are not included.
+func SumAllocations(zone *v1alpha1.TopologyZone) v1.ResourceList {
+	result := v1.ResourceList{}
+	for _, allocation := range zone.Allocations {
+		if allocation == nil || allocation.Requests == nil {
+			continue
+		}
+
+		for name, quantity := range *allocation.Requests {
+			sum := result[name]
+			sum.Add(quantity)
+			result[name] = sum
+		}
+	}
+	return result
+}
+
+// AllocationsByZone returns the total requests of allocations for each zone in the tree.
+func AllocationsByZone(zones []*v1alpha1.TopologyZone) map[ZoneKey]v1.ResourceList {
+	result := make(map[ZoneKey]v1.ResourceList)
+	_ = Walk(zones, func(zone *v1alpha1.TopologyZone, _ []*v1alpha1.TopologyZone) error {
+		result[KeyOf(zone)] = SumAllocations(zone)
+		return nil
+	})
+	return result
+}
+
+// Free returns allocatable minus the total requests of allocations for each resource in
+// allocatable of the zone; the result may be negative if the zone is over-allocated, and
+// it's nil if the zone has no allocatable.
+func Free(zone *v1alpha1.TopologyZone) v1.ResourceList {
+	if zone.Resources.Allocatable == nil {
+		return nil
+	}
+
+	allocated := SumAllocations(zone)
+	result := make(v1.ResourceList, len(*zone.Resources.Allocatable))
+	for name, allocatable := range *zone.Resources.Allocatable {
+		free := allocatable.DeepCopy()
+		if quantity, ok := allocated[name]; ok {
+			free.Sub(quantity)
+		}
+		result[name] = free
+	}
+	return result
+}
+
+// FreeByZone returns the free resources for each zone in the tree which has allocatable.
+func FreeByZone(zones []*v1alpha1.TopologyZone) map[ZoneKey]v1.ResourceList {
+	result := make(map[ZoneKey]v1.ResourceList)
+	_ = Walk(zones, func(zone *v1alpha1.TopologyZone, _ []*v1alpha1.TopologyZone) error {
+		if free := Free(zone); free != nil {
+			result[KeyOf(zone)] = free
+		}
+		return nil
+	})
+	return result
+}
+
+// SetAllocation records the flat allocation of the consumer into the tree, and the previous
+// allocations of the consumer are replaced; the tree is not changed if any zone in the
+// allocation is not found. Attributes of zone allocations are kept in the allocations of
+// the consumer rather than the zones, so they are removed along with the allocations.
+func SetAllocation(zones []*v1alpha1.TopologyZone, consumer string, allocation v1alpha1.TopologyAllocation) error {
+	targets := make(map[*v1alpha1.TopologyZone]v1alpha1.ZoneAllocation)
+	for _, key := range sortedAllocationKeys(allocation) {
+		zone := Find(zones, key.Type, key.Name)
+		if zone == nil {
+			return fmt.Errorf("zone %v of consumer %s is not found", key, consumer)
+		}
+		targets[zone] = allocation[key.Type][key.Name]
+	}
+
+	RemoveAllocation(zones, consumer)
+	_ = Walk(zones, func(zone *v1alpha1.TopologyZone, _ []*v1alpha1.TopologyZone) error {
+		if zoneAllocation, ok := targets[zone]; ok {
+			requests := zoneAllocation.Allocated.DeepCopy()
+			zone.Allocations = append(zone.Allocations, &v1alpha1.Allocation{
+				Consumer:   consumer,
+				Requests:   &requests,
+				Attributes: copyStringMap(zoneAllocation.Attributes),
+			})
+		}
+		return nil
+	})
+	return nil
+}
+
+// ParentFunc returns the key of the parent zone of the zone to be created, and false if the
+// zone should be created at the top level of the tree.
+type ParentFunc func(key ZoneKey) (ZoneKey, bool)
+
+// BuildAllocation is like SetAllocation, but zones in the allocation which are not found are
+// created under the parents returned by parentOf, since the flat allocation doesn't tell them;
+// parents may be zones created for the allocation as well. The resulting tree is returned, and
+// the given tree is not changed if any zone is missing while parentOf is nil or its parent
+// can't be found.
+func BuildAllocation(zones []*v1alpha1.TopologyZone, consumer string,
+	allocation v1alpha1.TopologyAllocation, parentOf ParentFunc,
+) ([]*v1alpha1.TopologyZone, error) {
+	var pending []ZoneKey
+	for _, key := range sortedAllocationKeys(allocation) {
+		if Find(zones, key.Type, key.Name) == nil {
+			if parentOf == nil {
+				return zones, fmt.Errorf("zone %v of consumer %s is not found", key, consumer)
+			}
+			pending = append(pending, key)
+		}
+	}
+
+	// resolve the order of creation first, so that the tree is not changed on error
+	type creation struct {
+		key, parent ZoneKey
+		topLevel    bool
+	}
+	var creations []creation
+	created := make(map[ZoneKey]bool)
+	for len(pending) > 0 {
+		var rest []ZoneKey
+		for _, key := range pending {
+			parent, ok := parentOf(key)
+			switch {
+			case !ok:
+				creations = append(creations, creation{key: key, topLevel: true})
+			case created[parent] || Find(zones, parent.Type, parent.Name) != nil:
+				creations = append(creations, creation{key: key, parent: parent})
+			default:
+				rest = append(rest, key)
+				continue
+			}
+			created[key] = true
+		}
+
+		if len(rest) == len(pending) {
+			key, parent := unresolvedZone(rest, parentOf)
+			return zones, fmt.Errorf("parent %v of zone %v of consumer %s is not found", parent, key, consumer)
+		}
+		pending = rest
+	}
+
+	for _, c := range creations {
+		zone := &v1alpha1.TopologyZone{Type: c.key.Type, Name: c.key.Name}
+		if c.topLevel {
+			zones = append(zones, zone)
+		} else {
+			parent := Find(zones, c.parent.Type, c.parent.Name)
+			parent.Children = append(parent.Children, zone)
+		}
+	}
+
+	// all the zones in the allocation exist now, so it never fails
+	_ = SetAllocation(zones, consumer, allocation)
+	return zones, nil
+}
+
+// RemoveAllocation removes all the allocations of the consumer from the tree.
+func RemoveAllocation(zones []*v1alpha1.TopologyZone, consumer string) {
+	_ = Walk(zones, func(zone *v1alpha1.TopologyZone, _ []*v1alpha1.TopologyZone) error {
+		allocations := zone.Allocations[:0]
+		for _, allocation := range zone.Allocations {
+			if allocation != nil && allocation.Consumer != consumer {
+				allocations = append(allocations, allocation)
+			}
+		}
+		if len(allocations) == 0 {
+			allocations = nil
+		}
+		zone.Allocations = allocations
+		return nil
+	})
+}
+
+// GetAllocation returns the flat allocation of the consumer in the tree, and returns
+// nil if the consumer has no allocation.
+func GetAllocation(zones []*v1alpha1.TopologyZone, consumer string) v1alpha1.TopologyAllocation {
+	var result v1alpha1.TopologyAllocation
+	_ = Walk(zones, func(zone *v1alpha1.TopologyZone, _ []*v1alpha1.TopologyZone) error {
+		for _, allocation := range zone.Allocations {
+			if allocation == nil || allocation.Consumer != consumer {
+				continue
+			}
+
+			if result == nil {
+				result = make(v1alpha1.TopologyAllocation)
+			}
+			if result[zone.Type] == nil {
+				result[zone.Type] = make(map[string]v1alpha1.ZoneAllocation)
+			}
+
+			var allocated v1.ResourceList
+			if allocation.Requests != nil {
+				allocated = allocation.Requests.DeepCopy()
+			}
+			result[zone.Type][zone.Name] = v1alpha1.ZoneAllocation{
+				Allocated:  allocated,
+				Attributes: copyStringMap(allocation.Attributes),
+			}
+		}
+		return nil
+	})
+	return result
+}
+
+// unresolvedZone returns the first pending zone whose parent is not pending as well, or the
+// first pending zone if their parents form a cycle.
+func unresolvedZone(pending []ZoneKey, parentOf ParentFunc) (ZoneKey, ZoneKey) {
+	keys := make(map[ZoneKey]bool, len(pending))
+	for _, key := range pending {
+		keys[key] = true
+	}
+
+	for _, key := range pending {
+		if parent, _ := parentOf(key); !keys[parent] {
+			return key, parent
+		}
+	}
+	parent, _ := parentOf(pending[0])
+	return pending[0], parent
+}
+
+func copyStringMap(m map[string]string) map[string]string {
+	if m == nil {
+		return nil
+	}
+
+	result := make(map[string]string, len(m))
+	for k, v := range m {
+		result[k] = v
+	}
+	return result
+}
+
+// sortedAllocationKeys returns keys of all the zones in the allocation in a stable order
+func sortedAllocationKeys(allocation v1alpha1.TopologyAllocation) []ZoneKey {
+	var keys []ZoneKey
+	for zoneType, zones := range allocation {
+		for name := range zones {
+			keys = append(keys, ZoneKey{Type: zoneType, Name: name})
+		}
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return lessZoneKey(keys[i], keys[j])
+	})
+	return keys
+}
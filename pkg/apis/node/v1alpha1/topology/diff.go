@@ -0,0 +1,322 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topology
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+
+	"github.com/kubewharf/katalyst-api/pkg/apis/node/v1alpha1"
+)
+
+// ChangeType is the type of change reported by Diff.
+type ChangeType string
+
+const (
+	ChangeTypeAdded    ChangeType = "Added"
+	ChangeTypeRemoved  ChangeType = "Removed"
+	ChangeTypeModified ChangeType = "Modified"
+)
+
+const (
+	// ZoneFieldResources and the followings are the fields reported in ZoneChange
+	ZoneFieldResources = "resources"
+	ZoneFieldSiblings  = "siblings"
+	ZoneFieldParent    = "parent"
+)
+
+// ZoneChange describes an added or removed zone, or a zone whose resources, siblings
+// or parent changed; changes of attributes and allocations are reported separately.
+type ZoneChange struct {
+	Zone ZoneKey
+	Type ChangeType
+	// Fields are the changed fields of modified zones, such as resources and siblings.
+	Fields []string
+}
+
+// AllocationChange describes a changed allocation of a consumer in a zone.
+type AllocationChange struct {
+	Zone     ZoneKey
+	Consumer string
+	Type     ChangeType
+	Old, New *v1alpha1.Allocation
+}
+
+// AttributeChange describes a changed attribute of a zone.
+type AttributeChange struct {
+	Zone               ZoneKey
+	Name               string
+	Type               ChangeType
+	OldValue, NewValue string
+}
+
+// TopologyDiff is the structural difference between two zone trees.
+type TopologyDiff struct {
+	Zones       []ZoneChange
+	Allocations []AllocationChange
+	Attributes  []AttributeChange
+}
+
+// Empty returns whether nothing changed
+func (d *TopologyDiff) Empty() bool {
+	return len(d.Zones) == 0 && len(d.Allocations) == 0 && len(d.Attributes) == 0
+}
+
+// String returns a brief summary of the changes, which is suitable for events.
+func (d *TopologyDiff) String() string {
+	var parts []string
+	for _, c := range d.Zones {
+		if c.Type == ChangeTypeModified {
+			parts = append(parts, fmt.Sprintf("zone %v %s %s", c.Zone, strings.ToLower(string(c.Type)), strings.Join(c.Fields, ",")))
+		} else {
+			parts = append(parts, fmt.Sprintf("zone %v %s", c.Zone, strings.ToLower(string(c.Type))))
+		}
+	}
+	for _, c := range d.Allocations {
+		parts = append(parts, fmt.Sprintf("allocation of %s in zone %v %s", c.Consumer, c.Zone, strings.ToLower(string(c.Type))))
+	}
+	for _, c := range d.Attributes {
+		parts = append(parts, fmt.Sprintf("attribute %s of zone %v %s", c.Name, c.Zone, strings.ToLower(string(c.Type))))
+	}
+	return strings.Join(parts, "; ")
+}
+
+// indexedZone is a zone with the key of its parent
+type indexedZone struct {
+	zone   *v1alpha1.TopologyZone
+	parent *ZoneKey
+}
+
+// Diff compares two zone trees by the zone keys and patch merge keys, so the order of lists
+// doesn't matter; attributes and allocations of added or removed zones are also reported.
+// If multiple zones, attributes or allocations have the same key, only the last one counts.
+func Diff(oldZones, newZones []*v1alpha1.TopologyZone) *TopologyDiff {
+	oldIndex, newIndex := indexZones(oldZones), indexZones(newZones)
+
+	keys := make([]ZoneKey, 0, len(oldIndex)+len(newIndex))
+	for key := range oldIndex {
+		keys = append(keys, key)
+	}
+	for key := range newIndex {
+		if _, ok := oldIndex[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return lessZoneKey(keys[i], keys[j])
+	})
+
+	diff := &TopologyDiff{}
+	for _, key := range keys {
+		oldZone, oldOK := oldIndex[key]
+		newZone, newOK := newIndex[key]
+
+		var oldValue, newValue *v1alpha1.TopologyZone
+		switch {
+		case !oldOK:
+			diff.Zones = append(diff.Zones, ZoneChange{Zone: key, Type: ChangeTypeAdded})
+			newValue = newZone.zone
+		case !newOK:
+			diff.Zones = append(diff.Zones, ZoneChange{Zone: key, Type: ChangeTypeRemoved})
+			oldValue = oldZone.zone
+		default:
+			oldValue, newValue = oldZone.zone, newZone.zone
+			if fields := diffZoneFields(oldZone, newZone); len(fields) > 0 {
+				diff.Zones = append(diff.Zones, ZoneChange{Zone: key, Type: ChangeTypeModified, Fields: fields})
+			}
+		}
+
+		diff.Allocations = append(diff.Allocations, diffAllocations(key, oldValue, newValue)...)
+		diff.Attributes = append(diff.Attributes, diffAttributes(key, oldValue, newValue)...)
+	}
+	return diff
+}
+
+// DiffStatus compares the topology zones of two cnr status.
+func DiffStatus(oldStatus, newStatus *v1alpha1.CustomNodeResourceStatus) *TopologyDiff {
+	return Diff(oldStatus.TopologyZone, newStatus.TopologyZone)
+}
+
+func indexZones(zones []*v1alpha1.TopologyZone) map[ZoneKey]indexedZone {
+	index := make(map[ZoneKey]indexedZone)
+	_ = Walk(zones, func(zone *v1alpha1.TopologyZone, parents []*v1alpha1.TopologyZone) error {
+		var parent *ZoneKey
+		if len(parents) > 0 {
+			key := KeyOf(parents[len(parents)-1])
+			parent = &key
+		}
+		index[KeyOf(zone)] = indexedZone{zone: zone, parent: parent}
+		return nil
+	})
+	return index
+}
+
+func diffZoneFields(oldZone, newZone indexedZone) []string {
+	var fields []string
+	if !equality.Semantic.DeepEqual(oldZone.zone.Resources, newZone.zone.Resources) {
+		fields = append(fields, ZoneFieldResources)
+	}
+
+	oldSiblings := append([]v1alpha1.Sibling(nil), oldZone.zone.Siblings...)
+	newSiblings := append([]v1alpha1.Sibling(nil), newZone.zone.Siblings...)
+	sortSiblings(oldSiblings)
+	sortSiblings(newSiblings)
+	if !siblingsEqual(oldSiblings, newSiblings) {
+		fields = append(fields, ZoneFieldSiblings)
+	}
+
+	if (oldZone.parent == nil) != (newZone.parent == nil) ||
+		(oldZone.parent != nil && *oldZone.parent != *newZone.parent) {
+		fields = append(fields, ZoneFieldParent)
+	}
+	return fields
+}
+
+// siblingsEqual compares sorted siblings regardless of the order of their attributes
+func siblingsEqual(a, b []v1alpha1.Sibling) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i].Type != b[i].Type || a[i].Name != b[i].Name {
+			return false
+		}
+
+		oldAttributes, newAttributes := attributeMap(a[i].Attributes), attributeMap(b[i].Attributes)
+		if len(oldAttributes) != len(newAttributes) {
+			return false
+		}
+		for name, value := range oldAttributes {
+			if newValue, ok := newAttributes[name]; !ok || newValue != value {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func diffAllocations(key ZoneKey, oldZone, newZone *v1alpha1.TopologyZone) []AllocationChange {
+	oldAllocations, newAllocations := allocationMap(oldZone), allocationMap(newZone)
+
+	var changes []AllocationChange
+	for _, consumer := range unionKeys(allocationConsumers(oldAllocations), allocationConsumers(newAllocations)) {
+		oldAllocation, oldOK := oldAllocations[consumer]
+		newAllocation, newOK := newAllocations[consumer]
+
+		change := AllocationChange{Zone: key, Consumer: consumer, Old: oldAllocation, New: newAllocation}
+		switch {
+		case !oldOK:
+			change.Type = ChangeTypeAdded
+		case !newOK:
+			change.Type = ChangeTypeRemoved
+		case !equality.Semantic.DeepEqual(oldAllocation, newAllocation):
+			change.Type = ChangeTypeModified
+		default:
+			continue
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+func diffAttributes(key ZoneKey, oldZone, newZone *v1alpha1.TopologyZone) []AttributeChange {
+	var oldAttributes, newAttributes map[string]string
+	if oldZone != nil {
+		oldAttributes = attributeMap(oldZone.Attributes)
+	}
+	if newZone != nil {
+		newAttributes = attributeMap(newZone.Attributes)
+	}
+
+	var changes []AttributeChange
+	for _, name := range unionKeys(stringMapKeys(oldAttributes), stringMapKeys(newAttributes)) {
+		oldValue, oldOK := oldAttributes[name]
+		newValue, newOK := newAttributes[name]
+
+		change := AttributeChange{Zone: key, Name: name, OldValue: oldValue, NewValue: newValue}
+		switch {
+		case !oldOK:
+			change.Type = ChangeTypeAdded
+		case !newOK:
+			change.Type = ChangeTypeRemoved
+		case oldValue != newValue:
+			change.Type = ChangeTypeModified
+		default:
+			continue
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+func allocationMap(zone *v1alpha1.TopologyZone) map[string]*v1alpha1.Allocation {
+	result := make(map[string]*v1alpha1.Allocation)
+	if zone == nil {
+		return result
+	}
+
+	for _, allocation := range zone.Allocations {
+		if allocation != nil {
+			result[allocation.Consumer] = allocation
+		}
+	}
+	return result
+}
+
+func allocationConsumers(allocations map[string]*v1alpha1.Allocation) []string {
+	consumers := make([]string, 0, len(allocations))
+	for consumer := range allocations {
+		consumers = append(consumers, consumer)
+	}
+	return consumers
+}
+
+func attributeMap(attributes []v1alpha1.Attribute) map[string]string {
+	result := make(map[string]string, len(attributes))
+	for _, attribute := range attributes {
+		result[attribute.Name] = attribute.Value
+	}
+	return result
+}
+
+func stringMapKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// unionKeys returns the sorted union of two key lists without duplication
+func unionKeys(a, b []string) []string {
+	seen := make(map[string]bool, len(a)+len(b))
+	var result []string
+	for _, keys := range [][]string{a, b} {
+		for _, key := range keys {
+			if !seen[key] {
+				seen[key] = true
+				result = append(result, key)
+			}
+		}
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,244 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topology
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/kubewharf/katalyst-api/pkg/apis/node/v1alpha1"
+)
+
+// Distances between zones are in the unit of ACPI SLIT, so that numa distances reported in sibling
+// attributes can be compared with the distances between devices directly.
+const (
+	// DistanceSelf is the distance from a zone to itself
+	DistanceSelf = 0
+	// DistanceLinked is the distance between devices connected by nvlink or xgmi
+	DistanceLinked = 5
+	// DistancePCIRoot is the distance between devices sharing the same pci root complex
+	DistancePCIRoot = 8
+	// DistanceLocalNUMA is the distance between zones in the same numa zone
+	DistanceLocalNUMA = 10
+	// DistanceSameSocket is the default distance between numa zones in the same socket
+	// if numa distance is not reported
+	DistanceSameSocket = 11
+	// DistanceRemoteSocket is the default distance between numa zones in different sockets
+	// if numa distance is not reported
+	DistanceRemoteSocket = 21
+)
+
+// DistanceMatrix is the distances between any two zones in a zone tree.
+type DistanceMatrix struct {
+	keys      []ZoneKey
+	index     map[ZoneKey]int
+	distances [][]int
+}
+
+// NewDistanceMatrix computes the distances between zones in the tree, and the distance between
+// two zones is decided by the first matched rule below:
+//   - the same zone: DistanceSelf
+//   - connected by sibling attribute nvlink or xgmi: DistanceLinked
+//   - sharing sibling attribute pci-root: DistancePCIRoot
+//   - both are or are under numa zones: distance between the numa zones, which is DistanceLocalNUMA
+//     for the same numa, or sibling attribute numa-distance, or the default by sockets
+//
+// Otherwise the distance is unknown. Zones with the same key are counted only once.
+func NewDistanceMatrix(zones []*v1alpha1.TopologyZone) *DistanceMatrix {
+	m := &DistanceMatrix{index: make(map[ZoneKey]int)}
+
+	var numaOf, socketOf []*v1alpha1.TopologyZone
+	var zoneList []*v1alpha1.TopologyZone
+	_ = Walk(zones, func(zone *v1alpha1.TopologyZone, parents []*v1alpha1.TopologyZone) error {
+		key := KeyOf(zone)
+		if _, ok := m.index[key]; ok {
+			return nil
+		}
+
+		var numa, socket *v1alpha1.TopologyZone
+		for _, z := range append(append([]*v1alpha1.TopologyZone(nil), parents...), zone) {
+			switch z.Type {
+			case v1alpha1.TopologyTypeNuma:
+				numa = z
+			case v1alpha1.TopologyTypeSocket:
+				socket = z
+			}
+		}
+
+		m.index[key] = len(m.keys)
+		m.keys = append(m.keys, key)
+		zoneList = append(zoneList, zone)
+		numaOf = append(numaOf, numa)
+		socketOf = append(socketOf, socket)
+		return nil
+	})
+
+	m.distances = make([][]int, len(m.keys))
+	for i := range m.keys {
+		m.distances[i] = make([]int, len(m.keys))
+	}
+
+	for i := range m.keys {
+		for j := i; j < len(m.keys); j++ {
+			var distance int
+			if i == j {
+				distance = DistanceSelf
+			} else {
+				distance = zoneDistance(zoneList[i], zoneList[j], numaOf[i], numaOf[j], socketOf[i], socketOf[j])
+			}
+			m.distances[i][j], m.distances[j][i] = distance, distance
+		}
+	}
+	return m
+}
+
+// NewDistanceMatrixForCNR computes the distance matrix of the topology zones in the cnr.
+func NewDistanceMatrixForCNR(cnr *v1alpha1.CustomNodeResource) *DistanceMatrix {
+	return NewDistanceMatrix(cnr.Status.TopologyZone)
+}
+
+// Keys returns keys of all the zones in the matrix in the walking order of the tree.
+func (m *DistanceMatrix) Keys() []ZoneKey {
+	return append([]ZoneKey(nil), m.keys...)
+}
+
+// Distance returns the distance between two zones, and false if either zone is not found
+// or the distance is unknown.
+func (m *DistanceMatrix) Distance(a, b ZoneKey) (int, bool) {
+	i, ok := m.index[a]
+	if !ok {
+		return 0, false
+	}
+	j, ok := m.index[b]
+	if !ok {
+		return 0, false
+	}
+
+	distance := m.distances[i][j]
+	return distance, distance >= 0
+}
+
+// SortByDistance sorts the candidates by their distances to the given zone in place, and the
+// candidates with unknown distances are put at the end; the order is stable for ties.
+func (m *DistanceMatrix) SortByDistance(from ZoneKey, candidates []ZoneKey) {
+	sort.SliceStable(candidates, func(i, j int) bool {
+		di, iok := m.Distance(from, candidates[i])
+		dj, jok := m.Distance(from, candidates[j])
+		if iok != jok {
+			return iok
+		}
+		return di < dj
+	})
+}
+
+// Closest returns the zones of the given type closest to the zone, and returns nil if
+// no zone of the type has known distance.
+func (m *DistanceMatrix) Closest(from ZoneKey, zoneType v1alpha1.TopologyType) []ZoneKey {
+	var result []ZoneKey
+	closest := -1
+	for _, key := range m.keys {
+		if key.Type != zoneType || key == from {
+			continue
+		}
+
+		distance, ok := m.Distance(from, key)
+		if !ok {
+			continue
+		}
+
+		if closest < 0 || distance < closest {
+			closest, result = distance, []ZoneKey{key}
+		} else if distance == closest {
+			result = append(result, key)
+		}
+	}
+	return result
+}
+
+// zoneDistance returns the distance between two different zones, or -1 if it's unknown
+func zoneDistance(a, b, numaA, numaB, socketA, socketB *v1alpha1.TopologyZone) int {
+	attributes := siblingAttributes(a, b)
+	for name, value := range siblingAttributes(b, a) {
+		if _, ok := attributes[name]; !ok {
+			attributes[name] = value
+		}
+	}
+
+	if linked(attributes[v1alpha1.SiblingAttributeNVLink]) || linked(attributes[v1alpha1.SiblingAttributeXGMI]) {
+		return DistanceLinked
+	}
+	if attributes[v1alpha1.SiblingAttributePCIRoot] != "" {
+		return DistancePCIRoot
+	}
+
+	if numaA == nil || numaB == nil {
+		return -1
+	}
+	if KeyOf(numaA) == KeyOf(numaB) {
+		return DistanceLocalNUMA
+	}
+
+	if distance, ok := numaDistance(numaA, numaB); ok {
+		return distance
+	}
+	if distance, ok := numaDistance(numaB, numaA); ok {
+		return distance
+	}
+
+	if socketA != nil && socketB != nil && KeyOf(socketA) == KeyOf(socketB) {
+		return DistanceSameSocket
+	}
+	return DistanceRemoteSocket
+}
+
+// siblingAttributes returns attributes of the sibling b in zone a
+func siblingAttributes(a, b *v1alpha1.TopologyZone) map[string]string {
+	result := make(map[string]string)
+	for _, sibling := range a.Siblings {
+		if sibling.Type == b.Type && sibling.Name == b.Name {
+			for name, value := range attributeMap(sibling.Attributes) {
+				result[name] = value
+			}
+		}
+	}
+	return result
+}
+
+func numaDistance(a, b *v1alpha1.TopologyZone) (int, bool) {
+	value, ok := siblingAttributes(a, b)[v1alpha1.SiblingAttributeNUMADistance]
+	if !ok {
+		return 0, false
+	}
+
+	distance, err := strconv.Atoi(value)
+	if err != nil || distance < 0 {
+		return 0, false
+	}
+	return distance, true
+}
+
+// linked returns whether the link count is positive, and "true" is also accepted
+func linked(value string) bool {
+	if value == "" {
+		return false
+	}
+	if count, err := strconv.Atoi(value); err == nil {
+		return count > 0
+	}
+	linked, err := strconv.ParseBool(value)
+	return err == nil && linked
+}
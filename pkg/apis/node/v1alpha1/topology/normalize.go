@@ -0,0 +1,92 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topology
+
+import (
+	"sort"
+
+	"github.com/kubewharf/katalyst-api/pkg/apis/node/v1alpha1"
+)
+
+// Normalize sorts the zone tree in place to make it deterministic regardless of the order
+// reported by different reporters: zones and siblings are sorted by type and name, attributes
+// by name and allocations by consumer, which are the patch merge keys of those lists.
+func Normalize(zones []*v1alpha1.TopologyZone) {
+	sortZones(zones)
+	for _, zone := range zones {
+		if zone == nil {
+			continue
+		}
+
+		sortAttributes(zone.Attributes)
+		sortAllocations(zone.Allocations)
+		sortSiblings(zone.Siblings)
+		for i := range zone.Siblings {
+			sortAttributes(zone.Siblings[i].Attributes)
+		}
+
+		Normalize(zone.Children)
+	}
+}
+
+// NormalizeStatus normalizes the topology zones of cnr status in place.
+func NormalizeStatus(status *v1alpha1.CustomNodeResourceStatus) {
+	Normalize(status.TopologyZone)
+}
+
+// sortZones sorts zones by type and name, and nil zones are put at the end
+func sortZones(zones []*v1alpha1.TopologyZone) {
+	sort.SliceStable(zones, func(i, j int) bool {
+		if zones[i] == nil || zones[j] == nil {
+			return zones[j] == nil && zones[i] != nil
+		}
+		return lessZoneKey(KeyOf(zones[i]), KeyOf(zones[j]))
+	})
+}
+
+// sortAllocations sorts allocations by consumer, and nil allocations are put at the end
+func sortAllocations(allocations []*v1alpha1.Allocation) {
+	sort.SliceStable(allocations, func(i, j int) bool {
+		if allocations[i] == nil || allocations[j] == nil {
+			return allocations[j] == nil && allocations[i] != nil
+		}
+		return allocations[i].Consumer < allocations[j].Consumer
+	})
+}
+
+func sortAttributes(attributes []v1alpha1.Attribute) {
+	sort.SliceStable(attributes, func(i, j int) bool {
+		if attributes[i].Name != attributes[j].Name {
+			return attributes[i].Name < attributes[j].Name
+		}
+		return attributes[i].Value < attributes[j].Value
+	})
+}
+
+func sortSiblings(siblings []v1alpha1.Sibling) {
+	sort.SliceStable(siblings, func(i, j int) bool {
+		return lessZoneKey(ZoneKey{Type: siblings[i].Type, Name: siblings[i].Name},
+			ZoneKey{Type: siblings[j].Type, Name: siblings[j].Name})
+	})
+}
+
+func lessZoneKey(a, b ZoneKey) bool {
+	if a.Type != b.Type {
+		return a.Type < b.Type
+	}
+	return a.Name < b.Name
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package topology provides helpers to walk, search and mutate the TopologyZone
+// tree reported in CustomNodeResourceStatus, so that components consuming it
+// (scheduler, agent reporter and so on) don't need to reimplement them.
+package topology // import "github.com/kubewharf/katalyst-api/pkg/apis/node/v1alpha1/topology"
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/kubewharf/katalyst-api/pkg/apis/node/v1alpha1"
+)
+
+// SkipChildren is used as a return value from WalkFunc to indicate that
+// children of the zone passed to the call are to be skipped.
+var SkipChildren = errors.New("skip children of this zone")
+
+// ZoneKey identifies a zone in the tree by its type and name.
+type ZoneKey struct {
+	Type v1alpha1.TopologyType
+	Name string
+}
+
+// KeyOf returns the key of the given zone
+func KeyOf(zone *v1alpha1.TopologyZone) ZoneKey {
+	return ZoneKey{Type: zone.Type, Name: zone.Name}
+}
+
+func (k ZoneKey) String() string {
+	return fmt.Sprintf("%s/%s", k.Type, k.Name)
+}
+
+// WalkFunc is called for each zone visited by Walk, and parents are the ancestors of
+// the zone from the root, which must not be retained after the call returns.
+type WalkFunc func(zone *v1alpha1.TopologyZone, parents []*v1alpha1.TopologyZone) error
+
+// Walk visits the zone tree in depth-first pre-order, and nil zones are ignored; if fn
+// returns SkipChildren, children of that zone are skipped, and any other error stops walking.
+func Walk(zones []*v1alpha1.TopologyZone, fn WalkFunc) error {
+	err := walk(zones, nil, fn)
+	if err == SkipChildren {
+		return nil
+	}
+	return err
+}
+
+func walk(zones []*v1alpha1.TopologyZone, parents []*v1alpha1.TopologyZone, fn WalkFunc) error {
+	for _, zone := range zones {
+		if zone == nil {
+			continue
+		}
+
+		if err := fn(zone, parents); err != nil {
+			if err == SkipChildren {
+				continue
+			}
+			return err
+		}
+
+		if err := walk(zone.Children, append(parents, zone), fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Find returns the first zone with the given type and name, and returns nil if not found.
+func Find(zones []*v1alpha1.TopologyZone, zoneType v1alpha1.TopologyType, name string) *v1alpha1.TopologyZone {
+	zone, _ := FindWithParent(zones, zoneType, name)
+	return zone
+}
+
+// FindWithParent returns the first zone with the given type and name together with
+// its parent, and the parent is nil for zones at the top level.
+func FindWithParent(zones []*v1alpha1.TopologyZone, zoneType v1alpha1.TopologyType,
+	name string) (zone, parent *v1alpha1.TopologyZone) {
+	errFound := errors.New("found")
+	_ = Walk(zones, func(z *v1alpha1.TopologyZone, parents []*v1alpha1.TopologyZone) error {
+		if z.Type != zoneType || z.Name != name {
+			return nil
+		}
+
+		zone = z
+		if len(parents) > 0 {
+			parent = parents[len(parents)-1]
+		}
+		return errFound
+	})
+	return zone, parent
+}
+
+// FindAll returns all the zones with the given type in depth-first pre-order.
+func FindAll(zones []*v1alpha1.TopologyZone, zoneType v1alpha1.TopologyType) []*v1alpha1.TopologyZone {
+	var result []*v1alpha1.TopologyZone
+	_ = Walk(zones, func(zone *v1alpha1.TopologyZone, _ []*v1alpha1.TopologyZone) error {
+		if zone.Type == zoneType {
+			result = append(result, zone)
+		}
+		return nil
+	})
+	return result
+}
+
+// ForEachNUMA calls fn for each numa zone under each socket zone, and stops at the first error.
+func ForEachNUMA(zones []*v1alpha1.TopologyZone, fn func(socket, numa *v1alpha1.TopologyZone) error) error {
+	for _, socket := range FindAll(zones, v1alpha1.TopologyTypeSocket) {
+		for _, numa := range FindAll(socket.Children, v1alpha1.TopologyTypeNuma) {
+			if err := fn(socket, numa); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// NUMANodesBySocket returns the numa zones under each socket zone by socket name.
+func NUMANodesBySocket(zones []*v1alpha1.TopologyZone) map[string][]*v1alpha1.TopologyZone {
+	result := make(map[string][]*v1alpha1.TopologyZone)
+	_ = ForEachNUMA(zones, func(socket, numa *v1alpha1.TopologyZone) error {
+		result[socket.Name] = append(result[socket.Name], numa)
+		return nil
+	})
+	return result
+}
+
+// Remove removes the first zone with the given type and name together with its children,
+// and returns the removed zone; nil is returned if not found.
+func Remove(zones []*v1alpha1.TopologyZone, zoneType v1alpha1.TopologyType,
+	name string) ([]*v1alpha1.TopologyZone, *v1alpha1.TopologyZone) {
+	zone, parent := FindWithParent(zones, zoneType, name)
+	if zone == nil {
+		return zones, nil
+	}
+
+	if parent == nil {
+		return removeZone(zones, zone), zone
+	}
+
+	parent.Children = removeZone(parent.Children, zone)
+	return zones, zone
+}
+
+func removeZone(zones []*v1alpha1.TopologyZone, target *v1alpha1.TopologyZone) []*v1alpha1.TopologyZone {
+	for i, zone := range zones {
+		if zone == target {
+			return append(zones[:i:i], zones[i+1:]...)
+		}
+	}
+	return zones
+}
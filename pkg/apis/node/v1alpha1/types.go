@@ -85,7 +85,12 @@ type CustomNodeResourceStatus struct {
 	// +optional
 	Resources Resources `json:"resources"`
 
+	// TopologyZone is a list-map keyed by type and name, so that field managers applying
+	// different zones don't overwrite each other with server-side apply.
 	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +listMapKey=name
 	TopologyZone []*TopologyZone `json:"topologyZone,omitempty"`
 
 	// TopologyPolicy indicates placement policy for scheduler or other centralized components to follow.
@@ -128,9 +133,13 @@ const (
 	TopologyPolicyBestEffortPodLevel TopologyPolicy = "BestEffortPodLevel"
 
 	// TopologyPolicyNumericContainerLevel represents numeric policy and container level.
+	// Numeric policy binds each container to exactly the number or set of numa nodes
+	// the pod asks for by annotations, instead of the narrowest set that satisfies its requests.
 	TopologyPolicyNumericContainerLevel TopologyPolicy = "NumericContainerLevel"
 
 	// TopologyPolicyNumericPodLevel represents numeric policy and pod level.
+	// Numeric policy binds the pod to exactly the number or set of numa nodes it asks for
+	// by annotations, instead of the narrowest set that satisfies its requests.
 	TopologyPolicyNumericPodLevel TopologyPolicy = "NumericPodLevel"
 )
 
@@ -156,6 +165,14 @@ type CNRConditionType string
 const (
 	CNRAgentReady    CNRConditionType = "AgentReady"
 	CNRAgentNotFound CNRConditionType = "AgentNotFound"
+
+	// CNRTopologyReported indicates whether TopologyZone and TopologyPolicy are reported
+	// by the agent, and its LastHeartbeatTime is refreshed on every report.
+	CNRTopologyReported CNRConditionType = "TopologyReported"
+
+	// CNRMetricReported indicates whether NodeMetricStatus is reported by the agent,
+	// and its LastHeartbeatTime is refreshed on every report.
+	CNRMetricReported CNRConditionType = "MetricReported"
 )
 
 type TopologyZone struct {
@@ -177,11 +194,15 @@ type TopologyZone struct {
 	// +optional
 	// +patchMergeKey=name
 	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=name
 	Attributes []Attribute `json:"attributes,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
 
 	// +optional
 	// +patchMergeKey=consumer
 	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=consumer
 	Allocations []*Allocation `json:"allocations,omitempty" patchStrategy:"merge" patchMergeKey:"consumer"`
 
 	// Children represents the ownerships between multiple TopologyZone; for instance,
@@ -293,6 +314,11 @@ type Allocation struct {
 	Consumer string `json:"consumer"`
 	// +optional
 	Requests *v1.ResourceList `json:"requests,omitempty"`
+
+	// Attributes are the additional attributes of this allocation specific to the consumer,
+	// such as ZoneAllocation.Attributes of the consumer in this zone.
+	// +optional
+	Attributes map[string]string `json:"attributes,omitempty"`
 }
 
 // Sibling describes the relationship between two Zones.
@@ -311,6 +337,22 @@ type Sibling struct {
 	Attributes []Attribute `json:"attributes,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
 }
 
+// well-known names of sibling attributes
+const (
+	// SiblingAttributeNUMADistance is the distance between two numa zones, which is the same
+	// as the value in ACPI SLIT, i.e. 10 for local access, e.g. "21".
+	SiblingAttributeNUMADistance = "numa-distance"
+
+	// SiblingAttributePCIRoot is the pci root complex shared by two device zones, e.g. "0000:00".
+	SiblingAttributePCIRoot = "pci-root"
+
+	// SiblingAttributeNVLink is the number of nvlink links between two gpu zones, e.g. "4".
+	SiblingAttributeNVLink = "nvlink"
+
+	// SiblingAttributeXGMI is the number of xgmi links between two gpu zones, e.g. "2".
+	SiblingAttributeXGMI = "xgmi"
+)
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // CustomNodeResourceList is a collection of CustomNodeResource objects.
@@ -464,6 +506,50 @@ type NodeProfileDescriptor struct {
 }
 
 type NodeProfileDescriptorSpec struct {
+	// NodeMetrics declares the node-related metrics to be collected for each scope,
+	// and the agent reports them in status.nodeMetrics with the same scope.
+	// +optional
+	// +listMapKey=scope
+	// +listType=map
+	NodeMetrics []ScopedMetricDeclarations `json:"nodeMetrics,omitempty"`
+
+	// PodMetrics declares the pod-related metrics to be collected for each scope,
+	// and the agent reports them in status.podMetrics with the same scope.
+	// +optional
+	// +listMapKey=scope
+	// +listType=map
+	PodMetrics []ScopedMetricDeclarations `json:"podMetrics,omitempty"`
+}
+
+type ScopedMetricDeclarations struct {
+	// Scope is the scope of the metrics, which is unique in the list. nodeMetrics and podMetrics
+	// are separate namespaces of scopes, so the same scope (e.g. a collector reporting both node
+	// and pod metrics) may appear in both of them.
+	Scope string `json:"scope"`
+
+	// +optional
+	Metrics []MetricDeclaration `json:"metrics,omitempty"`
+}
+
+// MetricDeclaration declares a metric to be collected.
+type MetricDeclaration struct {
+	// the name of the metric
+	MetricName string `json:"metricName"`
+
+	// Aggregators are applied to the samples in Window, and the metric is reported
+	// once for each aggregator; the instantaneous value is reported if empty.
+	// +optional
+	Aggregators []Aggregator `json:"aggregators,omitempty"`
+
+	// Window is the time window to aggregate the samples, and it's required if
+	// Aggregators is not empty.
+	// +optional
+	Window *metav1.Duration `json:"window,omitempty"`
+
+	// LabelSelector filters the time series of the metric by their labels,
+	// and all the time series are collected if it's nil.
+	// +optional
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
 }
 
 type NodeProfileDescriptorStatus struct {
@@ -474,6 +560,12 @@ type NodeProfileDescriptorStatus struct {
 	// PodMetrics contains the pod-related metrics
 	// +optional
 	PodMetrics []ScopedPodMetrics `json:"podMetrics,omitempty"`
+
+	// PodMetricShards are the names of npd objects holding the rest of pod metrics
+	// when they are too large to fit in this object; shards are labeled with the
+	// name of this object, and they only contain pod metrics.
+	// +optional
+	PodMetricShards []string `json:"podMetricShards,omitempty"`
 }
 
 type ScopedNodeMetrics struct {
@@ -542,6 +634,26 @@ type MetricValue struct {
 	// the value of the metric
 	// +optional
 	Value resource.Quantity `json:"value,omitempty"`
+
+	// Series is the history of the metric before Timestamp, and
+	// only the latest value is reported if it's nil. Since Timestamp is serialized
+	// in seconds, timestamps of all the points are truncated to seconds before
+	// encoding, so that they don't shift after a round trip through the apiserver.
+	// +optional
+	Series *MetricSeries `json:"series,omitempty"`
+}
+
+// MetricSeries contains the historical points of a metric in delta encoding to keep
+// the object small. Points are ordered from the newest to the oldest, and each point
+// is its newer neighbor (the first point's is the MetricValue itself) minus the deltas.
+type MetricSeries struct {
+	// TimestampDeltas are the milliseconds between each point and its newer neighbor.
+	TimestampDeltas []int64 `json:"timestampDeltas"`
+
+	// ValueDeltas are the differences in milli-units between each point and its newer neighbor,
+	// and it has the same length as TimestampDeltas. So values of metrics with series must be
+	// multiples of 1m, and both values and deltas must fit in int64 in milli-units (about 9.2e15).
+	ValueDeltas []int64 `json:"valueDeltas"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -42,6 +42,13 @@ func (in *Allocation) DeepCopyInto(out *Allocation) {
 			}
 		}
 	}
+	if in.Attributes != nil {
+		in, out := &in.Attributes, &out.Attributes
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -245,6 +252,63 @@ func (in *GroupMetricInfo) DeepCopy() *GroupMetricInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricDeclaration) DeepCopyInto(out *MetricDeclaration) {
+	*out = *in
+	if in.Aggregators != nil {
+		in, out := &in.Aggregators, &out.Aggregators
+		*out = make([]Aggregator, len(*in))
+		copy(*out, *in)
+	}
+	if in.Window != nil {
+		in, out := &in.Window, &out.Window
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.LabelSelector != nil {
+		in, out := &in.LabelSelector, &out.LabelSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricDeclaration.
+func (in *MetricDeclaration) DeepCopy() *MetricDeclaration {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricDeclaration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricSeries) DeepCopyInto(out *MetricSeries) {
+	*out = *in
+	if in.TimestampDeltas != nil {
+		in, out := &in.TimestampDeltas, &out.TimestampDeltas
+		*out = make([]int64, len(*in))
+		copy(*out, *in)
+	}
+	if in.ValueDeltas != nil {
+		in, out := &in.ValueDeltas, &out.ValueDeltas
+		*out = make([]int64, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricSeries.
+func (in *MetricSeries) DeepCopy() *MetricSeries {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricSeries)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MetricValue) DeepCopyInto(out *MetricValue) {
 	*out = *in
@@ -267,6 +331,11 @@ func (in *MetricValue) DeepCopyInto(out *MetricValue) {
 		**out = **in
 	}
 	out.Value = in.Value.DeepCopy()
+	if in.Series != nil {
+		in, out := &in.Series, &out.Series
+		*out = new(MetricSeries)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -354,7 +423,7 @@ func (in *NodeProfileDescriptor) DeepCopyInto(out *NodeProfileDescriptor) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
@@ -413,6 +482,20 @@ func (in *NodeProfileDescriptorList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeProfileDescriptorSpec) DeepCopyInto(out *NodeProfileDescriptorSpec) {
 	*out = *in
+	if in.NodeMetrics != nil {
+		in, out := &in.NodeMetrics, &out.NodeMetrics
+		*out = make([]ScopedMetricDeclarations, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PodMetrics != nil {
+		in, out := &in.PodMetrics, &out.PodMetrics
+		*out = make([]ScopedMetricDeclarations, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -443,6 +526,11 @@ func (in *NodeProfileDescriptorStatus) DeepCopyInto(out *NodeProfileDescriptorSt
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PodMetricShards != nil {
+		in, out := &in.PodMetricShards, &out.PodMetricShards
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -662,6 +750,29 @@ func (in *Resources) DeepCopy() *Resources {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScopedMetricDeclarations) DeepCopyInto(out *ScopedMetricDeclarations) {
+	*out = *in
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]MetricDeclaration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScopedMetricDeclarations.
+func (in *ScopedMetricDeclarations) DeepCopy() *ScopedMetricDeclarations {
+	if in == nil {
+		return nil
+	}
+	out := new(ScopedMetricDeclarations)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScopedNodeMetrics) DeepCopyInto(out *ScopedNodeMetrics) {
 	*out = *in
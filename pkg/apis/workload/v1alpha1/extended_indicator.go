@@ -0,0 +1,96 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	extendedIndicatorsLock sync.RWMutex
+	// extendedIndicatorsTypes maps names of extended indicators to the struct types of their indicators
+	extendedIndicatorsTypes = make(map[string]reflect.Type)
+)
+
+// RegisterExtendedIndicators registers the indicators type of the extended indicator with the name,
+// and the type is added to schemes as kind Name+ExtendedIndicatorSuffix of this group version by
+// AddToScheme. It should be called in init, since schemes built before are not affected.
+func RegisterExtendedIndicators(name string, indicators runtime.Object) error {
+	if name == "" {
+		return fmt.Errorf("extended indicator name is empty")
+	}
+
+	t := reflect.TypeOf(indicators)
+	if t == nil || t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("indicators of extended indicator %s must be a pointer to struct, got %T", name, indicators)
+	}
+
+	extendedIndicatorsLock.Lock()
+	defer extendedIndicatorsLock.Unlock()
+
+	if existing, ok := extendedIndicatorsTypes[name]; ok {
+		if existing != t.Elem() {
+			return fmt.Errorf("extended indicator %s is already registered with %v", name, existing)
+		}
+		return nil
+	}
+
+	extendedIndicatorsTypes[name] = t.Elem()
+	SchemeBuilder.Register(func(scheme *runtime.Scheme) error {
+		scheme.AddKnownTypeWithName(SchemeGroupVersion.WithKind(name+ExtendedIndicatorSuffix), indicators)
+		return nil
+	})
+	return nil
+}
+
+// MustRegisterExtendedIndicators is like RegisterExtendedIndicators but panics if error is returned.
+func MustRegisterExtendedIndicators(name string, indicators runtime.Object) {
+	if err := RegisterExtendedIndicators(name, indicators); err != nil {
+		panic(err)
+	}
+}
+
+// RegisteredExtendedIndicators returns the sorted names of all the registered extended indicators.
+func RegisteredExtendedIndicators() []string {
+	extendedIndicatorsLock.RLock()
+	defer extendedIndicatorsLock.RUnlock()
+
+	names := make([]string, 0, len(extendedIndicatorsTypes))
+	for name := range extendedIndicatorsTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NewExtendedIndicators returns an empty indicators object of the extended indicator, and
+// returns false if the name is not registered.
+func NewExtendedIndicators(name string) (runtime.Object, bool) {
+	extendedIndicatorsLock.RLock()
+	defer extendedIndicatorsLock.RUnlock()
+
+	t, ok := extendedIndicatorsTypes[name]
+	if !ok {
+		return nil, false
+	}
+	return reflect.New(t).Interface().(runtime.Object), true
+}
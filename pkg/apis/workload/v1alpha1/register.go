@@ -40,6 +40,10 @@ func Resource(resource string) schema.GroupResource {
 	return SchemeGroupVersion.WithResource(resource).GroupResource()
 }
 
+func init() {
+	MustRegisterExtendedIndicators("TestExtended", &TestExtendedIndicators{})
+}
+
 var (
 	// SchemeBuilder collects schemas to build.
 	SchemeBuilder = runtime.NewSchemeBuilder(addKnownTypes)
@@ -52,8 +56,6 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&ServiceProfileDescriptor{},
 		&ServiceProfileDescriptorList{},
-
-		&TestExtendedIndicators{},
 	)
 
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
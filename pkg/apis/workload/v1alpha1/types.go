@@ -50,9 +50,19 @@ type ServiceProfileDescriptor struct {
 
 // DecodeNestedObjects decodes extended indicator for known types.
 func (c *ServiceProfileDescriptor) DecodeNestedObjects(d runtime.Decoder) error {
+	return c.decodeNestedObjects(d, false)
+}
+
+// DecodeNestedObjectsStrict is like DecodeNestedObjects, but returns error for extended
+// indicators whose types are not registered in the decoder.
+func (c *ServiceProfileDescriptor) DecodeNestedObjectsStrict(d runtime.Decoder) error {
+	return c.decodeNestedObjects(d, true)
+}
+
+func (c *ServiceProfileDescriptor) decodeNestedObjects(d runtime.Decoder, strict bool) error {
 	for i := range c.Spec.ExtendedIndicator {
 		indicator := &c.Spec.ExtendedIndicator[i]
-		err := indicator.decodeNestedObjects(d)
+		err := indicator.decodeNestedObjects(d, strict)
 		if err != nil {
 			return fmt.Errorf("decoding .spec.extendedIndicator[%d]: %w", i, err)
 		}
@@ -137,10 +147,13 @@ const (
 	ExtendedIndicatorSuffix = "Indicators"
 )
 
-func (c *ServiceExtendedIndicatorSpec) decodeNestedObjects(d runtime.Decoder) error {
+func (c *ServiceExtendedIndicatorSpec) decodeNestedObjects(d runtime.Decoder, strict bool) error {
 	gvk := SchemeGroupVersion.WithKind(c.Name + ExtendedIndicatorSuffix)
 	// dry-run to detect and skip out-of-tree extended indicators.
 	if _, _, err := d.Decode(nil, &gvk, nil); runtime.IsNotRegisteredError(err) {
+		if strict {
+			return fmt.Errorf("extended indicators %s are not registered", c.Name)
+		}
 		return nil
 	}
 
@@ -0,0 +1,79 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// ContainerResourceListApplyConfiguration represents an declarative configuration of the ContainerResourceList type for use
+// with apply.
+type ContainerResourceListApplyConfiguration struct {
+	Current        *v1.ResourceList `json:"current,omitempty"`
+	Target         *v1.ResourceList `json:"target,omitempty"`
+	UncappedTarget *v1.ResourceList `json:"uncappedTarget,omitempty"`
+	LowerBound     *v1.ResourceList `json:"lowerBound,omitempty"`
+	UpperBound     *v1.ResourceList `json:"upperBound,omitempty"`
+}
+
+// ContainerResourceListApplyConfiguration constructs an declarative configuration of the ContainerResourceList type for use with
+// apply.
+func ContainerResourceList() *ContainerResourceListApplyConfiguration {
+	return &ContainerResourceListApplyConfiguration{}
+}
+
+// WithCurrent sets the Current field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Current field is set to the value of the last call.
+func (b *ContainerResourceListApplyConfiguration) WithCurrent(value v1.ResourceList) *ContainerResourceListApplyConfiguration {
+	b.Current = &value
+	return b
+}
+
+// WithTarget sets the Target field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Target field is set to the value of the last call.
+func (b *ContainerResourceListApplyConfiguration) WithTarget(value v1.ResourceList) *ContainerResourceListApplyConfiguration {
+	b.Target = &value
+	return b
+}
+
+// WithUncappedTarget sets the UncappedTarget field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UncappedTarget field is set to the value of the last call.
+func (b *ContainerResourceListApplyConfiguration) WithUncappedTarget(value v1.ResourceList) *ContainerResourceListApplyConfiguration {
+	b.UncappedTarget = &value
+	return b
+}
+
+// WithLowerBound sets the LowerBound field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LowerBound field is set to the value of the last call.
+func (b *ContainerResourceListApplyConfiguration) WithLowerBound(value v1.ResourceList) *ContainerResourceListApplyConfiguration {
+	b.LowerBound = &value
+	return b
+}
+
+// WithUpperBound sets the UpperBound field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UpperBound field is set to the value of the last call.
+func (b *ContainerResourceListApplyConfiguration) WithUpperBound(value v1.ResourceList) *ContainerResourceListApplyConfiguration {
+	b.UpperBound = &value
+	return b
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/autoscaling/v1alpha1"
+	v1 "k8s.io/api/core/v1"
+)
+
+// ContainerResourcePolicyApplyConfiguration represents an declarative configuration of the ContainerResourcePolicy type for use
+// with apply.
+type ContainerResourcePolicyApplyConfiguration struct {
+	ContainerName        *string                             `json:"containerName,omitempty"`
+	MinAllowed           *v1.ResourceList                    `json:"minAllowed,omitempty"`
+	MaxAllowed           *v1.ResourceList                    `json:"maxAllowed,omitempty"`
+	ControlledResources  []v1.ResourceName                   `json:"controlledResources,omitempty"`
+	ControlledValues     *v1alpha1.ContainerControlledValues `json:"controlledValues,omitempty"`
+	ResourceResizePolicy *v1alpha1.ResourceResizePolicy      `json:"resourceResizePolicy,omitempty"`
+}
+
+// ContainerResourcePolicyApplyConfiguration constructs an declarative configuration of the ContainerResourcePolicy type for use with
+// apply.
+func ContainerResourcePolicy() *ContainerResourcePolicyApplyConfiguration {
+	return &ContainerResourcePolicyApplyConfiguration{}
+}
+
+// WithContainerName sets the ContainerName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ContainerName field is set to the value of the last call.
+func (b *ContainerResourcePolicyApplyConfiguration) WithContainerName(value string) *ContainerResourcePolicyApplyConfiguration {
+	b.ContainerName = &value
+	return b
+}
+
+// WithMinAllowed sets the MinAllowed field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MinAllowed field is set to the value of the last call.
+func (b *ContainerResourcePolicyApplyConfiguration) WithMinAllowed(value v1.ResourceList) *ContainerResourcePolicyApplyConfiguration {
+	b.MinAllowed = &value
+	return b
+}
+
+// WithMaxAllowed sets the MaxAllowed field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxAllowed field is set to the value of the last call.
+func (b *ContainerResourcePolicyApplyConfiguration) WithMaxAllowed(value v1.ResourceList) *ContainerResourcePolicyApplyConfiguration {
+	b.MaxAllowed = &value
+	return b
+}
+
+// WithControlledResources adds the given value to the ControlledResources field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ControlledResources field.
+func (b *ContainerResourcePolicyApplyConfiguration) WithControlledResources(values ...v1.ResourceName) *ContainerResourcePolicyApplyConfiguration {
+	for i := range values {
+		b.ControlledResources = append(b.ControlledResources, values[i])
+	}
+	return b
+}
+
+// WithControlledValues sets the ControlledValues field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ControlledValues field is set to the value of the last call.
+func (b *ContainerResourcePolicyApplyConfiguration) WithControlledValues(value v1alpha1.ContainerControlledValues) *ContainerResourcePolicyApplyConfiguration {
+	b.ControlledValues = &value
+	return b
+}
+
+// WithResourceResizePolicy sets the ResourceResizePolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResourceResizePolicy field is set to the value of the last call.
+func (b *ContainerResourcePolicyApplyConfiguration) WithResourceResizePolicy(value v1alpha1.ResourceResizePolicy) *ContainerResourcePolicyApplyConfiguration {
+	b.ResourceResizePolicy = &value
+	return b
+}
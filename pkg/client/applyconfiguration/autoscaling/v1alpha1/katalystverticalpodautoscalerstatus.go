@@ -0,0 +1,72 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// KatalystVerticalPodAutoscalerStatusApplyConfiguration represents an declarative configuration of the KatalystVerticalPodAutoscalerStatus type for use
+// with apply.
+type KatalystVerticalPodAutoscalerStatusApplyConfiguration struct {
+	PodResources       []PodResourcesApplyConfiguration                   `json:"podResources,omitempty"`
+	ContainerResources []ContainerResourcesApplyConfiguration             `json:"containerResources,omitempty"`
+	Conditions         []VerticalPodAutoscalerConditionApplyConfiguration `json:"conditions,omitempty"`
+}
+
+// KatalystVerticalPodAutoscalerStatusApplyConfiguration constructs an declarative configuration of the KatalystVerticalPodAutoscalerStatus type for use with
+// apply.
+func KatalystVerticalPodAutoscalerStatus() *KatalystVerticalPodAutoscalerStatusApplyConfiguration {
+	return &KatalystVerticalPodAutoscalerStatusApplyConfiguration{}
+}
+
+// WithPodResources adds the given value to the PodResources field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the PodResources field.
+func (b *KatalystVerticalPodAutoscalerStatusApplyConfiguration) WithPodResources(values ...*PodResourcesApplyConfiguration) *KatalystVerticalPodAutoscalerStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithPodResources")
+		}
+		b.PodResources = append(b.PodResources, *values[i])
+	}
+	return b
+}
+
+// WithContainerResources adds the given value to the ContainerResources field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ContainerResources field.
+func (b *KatalystVerticalPodAutoscalerStatusApplyConfiguration) WithContainerResources(values ...*ContainerResourcesApplyConfiguration) *KatalystVerticalPodAutoscalerStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithContainerResources")
+		}
+		b.ContainerResources = append(b.ContainerResources, *values[i])
+	}
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *KatalystVerticalPodAutoscalerStatusApplyConfiguration) WithConditions(values ...*VerticalPodAutoscalerConditionApplyConfiguration) *KatalystVerticalPodAutoscalerStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
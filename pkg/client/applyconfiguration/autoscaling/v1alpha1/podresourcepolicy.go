@@ -0,0 +1,53 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// PodResourcePolicyApplyConfiguration represents an declarative configuration of the PodResourcePolicy type for use
+// with apply.
+type PodResourcePolicyApplyConfiguration struct {
+	AlgorithmPolicy   *AlgorithmPolicyApplyConfiguration          `json:"algorithmPolicy,omitempty"`
+	ContainerPolicies []ContainerResourcePolicyApplyConfiguration `json:"containerPolicies,omitempty"`
+}
+
+// PodResourcePolicyApplyConfiguration constructs an declarative configuration of the PodResourcePolicy type for use with
+// apply.
+func PodResourcePolicy() *PodResourcePolicyApplyConfiguration {
+	return &PodResourcePolicyApplyConfiguration{}
+}
+
+// WithAlgorithmPolicy sets the AlgorithmPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AlgorithmPolicy field is set to the value of the last call.
+func (b *PodResourcePolicyApplyConfiguration) WithAlgorithmPolicy(value *AlgorithmPolicyApplyConfiguration) *PodResourcePolicyApplyConfiguration {
+	b.AlgorithmPolicy = value
+	return b
+}
+
+// WithContainerPolicies adds the given value to the ContainerPolicies field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ContainerPolicies field.
+func (b *PodResourcePolicyApplyConfiguration) WithContainerPolicies(values ...*ContainerResourcePolicyApplyConfiguration) *PodResourcePolicyApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithContainerPolicies")
+		}
+		b.ContainerPolicies = append(b.ContainerPolicies, *values[i])
+	}
+	return b
+}
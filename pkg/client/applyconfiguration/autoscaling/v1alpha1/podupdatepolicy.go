@@ -0,0 +1,61 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/autoscaling/v1alpha1"
+)
+
+// PodUpdatePolicyApplyConfiguration represents an declarative configuration of the PodUpdatePolicy type for use
+// with apply.
+type PodUpdatePolicyApplyConfiguration struct {
+	PodUpdatingStrategy *v1alpha1.PodUpdatingStrategy `json:"podUpdatingStrategy,omitempty"`
+	PodMatchingStrategy *v1alpha1.PodMatchingStrategy `json:"podMatchingStrategy,omitempty"`
+	PodApplyStrategy    *v1alpha1.PodApplyStrategy    `json:"podApplyStrategy,omitempty"`
+}
+
+// PodUpdatePolicyApplyConfiguration constructs an declarative configuration of the PodUpdatePolicy type for use with
+// apply.
+func PodUpdatePolicy() *PodUpdatePolicyApplyConfiguration {
+	return &PodUpdatePolicyApplyConfiguration{}
+}
+
+// WithPodUpdatingStrategy sets the PodUpdatingStrategy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodUpdatingStrategy field is set to the value of the last call.
+func (b *PodUpdatePolicyApplyConfiguration) WithPodUpdatingStrategy(value v1alpha1.PodUpdatingStrategy) *PodUpdatePolicyApplyConfiguration {
+	b.PodUpdatingStrategy = &value
+	return b
+}
+
+// WithPodMatchingStrategy sets the PodMatchingStrategy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodMatchingStrategy field is set to the value of the last call.
+func (b *PodUpdatePolicyApplyConfiguration) WithPodMatchingStrategy(value v1alpha1.PodMatchingStrategy) *PodUpdatePolicyApplyConfiguration {
+	b.PodMatchingStrategy = &value
+	return b
+}
+
+// WithPodApplyStrategy sets the PodApplyStrategy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodApplyStrategy field is set to the value of the last call.
+func (b *PodUpdatePolicyApplyConfiguration) WithPodApplyStrategy(value v1alpha1.PodApplyStrategy) *PodUpdatePolicyApplyConfiguration {
+	b.PodApplyStrategy = &value
+	return b
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// RecommendedContainerResourcesApplyConfiguration represents an declarative configuration of the RecommendedContainerResources type for use
+// with apply.
+type RecommendedContainerResourcesApplyConfiguration struct {
+	ContainerName *string                                        `json:"containerName,omitempty"`
+	Requests      *RecommendedRequestResourcesApplyConfiguration `json:"requests,omitempty"`
+	Limits        *RecommendedRequestResourcesApplyConfiguration `json:"limits,omitempty"`
+}
+
+// RecommendedContainerResourcesApplyConfiguration constructs an declarative configuration of the RecommendedContainerResources type for use with
+// apply.
+func RecommendedContainerResources() *RecommendedContainerResourcesApplyConfiguration {
+	return &RecommendedContainerResourcesApplyConfiguration{}
+}
+
+// WithContainerName sets the ContainerName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ContainerName field is set to the value of the last call.
+func (b *RecommendedContainerResourcesApplyConfiguration) WithContainerName(value string) *RecommendedContainerResourcesApplyConfiguration {
+	b.ContainerName = &value
+	return b
+}
+
+// WithRequests sets the Requests field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Requests field is set to the value of the last call.
+func (b *RecommendedContainerResourcesApplyConfiguration) WithRequests(value *RecommendedRequestResourcesApplyConfiguration) *RecommendedContainerResourcesApplyConfiguration {
+	b.Requests = value
+	return b
+}
+
+// WithLimits sets the Limits field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Limits field is set to the value of the last call.
+func (b *RecommendedContainerResourcesApplyConfiguration) WithLimits(value *RecommendedRequestResourcesApplyConfiguration) *RecommendedContainerResourcesApplyConfiguration {
+	b.Limits = value
+	return b
+}
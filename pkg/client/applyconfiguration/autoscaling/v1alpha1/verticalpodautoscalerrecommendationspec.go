@@ -0,0 +1,58 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// VerticalPodAutoscalerRecommendationSpecApplyConfiguration represents an declarative configuration of the VerticalPodAutoscalerRecommendationSpec type for use
+// with apply.
+type VerticalPodAutoscalerRecommendationSpecApplyConfiguration struct {
+	PodRecommendations       []RecommendedPodResourcesApplyConfiguration       `json:"podRecommendations,omitempty"`
+	ContainerRecommendations []RecommendedContainerResourcesApplyConfiguration `json:"containerRecommendations,omitempty"`
+}
+
+// VerticalPodAutoscalerRecommendationSpecApplyConfiguration constructs an declarative configuration of the VerticalPodAutoscalerRecommendationSpec type for use with
+// apply.
+func VerticalPodAutoscalerRecommendationSpec() *VerticalPodAutoscalerRecommendationSpecApplyConfiguration {
+	return &VerticalPodAutoscalerRecommendationSpecApplyConfiguration{}
+}
+
+// WithPodRecommendations adds the given value to the PodRecommendations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the PodRecommendations field.
+func (b *VerticalPodAutoscalerRecommendationSpecApplyConfiguration) WithPodRecommendations(values ...*RecommendedPodResourcesApplyConfiguration) *VerticalPodAutoscalerRecommendationSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithPodRecommendations")
+		}
+		b.PodRecommendations = append(b.PodRecommendations, *values[i])
+	}
+	return b
+}
+
+// WithContainerRecommendations adds the given value to the ContainerRecommendations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ContainerRecommendations field.
+func (b *VerticalPodAutoscalerRecommendationSpecApplyConfiguration) WithContainerRecommendations(values ...*RecommendedContainerResourcesApplyConfiguration) *VerticalPodAutoscalerRecommendationSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithContainerRecommendations")
+		}
+		b.ContainerRecommendations = append(b.ContainerRecommendations, *values[i])
+	}
+	return b
+}
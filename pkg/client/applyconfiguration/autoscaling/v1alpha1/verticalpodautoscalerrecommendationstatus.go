@@ -0,0 +1,72 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// VerticalPodAutoscalerRecommendationStatusApplyConfiguration represents an declarative configuration of the VerticalPodAutoscalerRecommendationStatus type for use
+// with apply.
+type VerticalPodAutoscalerRecommendationStatusApplyConfiguration struct {
+	PodRecommendations       []RecommendedPodResourcesApplyConfiguration                      `json:"podRecommendations,omitempty"`
+	ContainerRecommendations []RecommendedContainerResourcesApplyConfiguration                `json:"containerRecommendations,omitempty"`
+	Conditions               []VerticalPodAutoscalerRecommendationConditionApplyConfiguration `json:"conditions,omitempty"`
+}
+
+// VerticalPodAutoscalerRecommendationStatusApplyConfiguration constructs an declarative configuration of the VerticalPodAutoscalerRecommendationStatus type for use with
+// apply.
+func VerticalPodAutoscalerRecommendationStatus() *VerticalPodAutoscalerRecommendationStatusApplyConfiguration {
+	return &VerticalPodAutoscalerRecommendationStatusApplyConfiguration{}
+}
+
+// WithPodRecommendations adds the given value to the PodRecommendations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the PodRecommendations field.
+func (b *VerticalPodAutoscalerRecommendationStatusApplyConfiguration) WithPodRecommendations(values ...*RecommendedPodResourcesApplyConfiguration) *VerticalPodAutoscalerRecommendationStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithPodRecommendations")
+		}
+		b.PodRecommendations = append(b.PodRecommendations, *values[i])
+	}
+	return b
+}
+
+// WithContainerRecommendations adds the given value to the ContainerRecommendations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ContainerRecommendations field.
+func (b *VerticalPodAutoscalerRecommendationStatusApplyConfiguration) WithContainerRecommendations(values ...*RecommendedContainerResourcesApplyConfiguration) *VerticalPodAutoscalerRecommendationStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithContainerRecommendations")
+		}
+		b.ContainerRecommendations = append(b.ContainerRecommendations, *values[i])
+	}
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *VerticalPodAutoscalerRecommendationStatusApplyConfiguration) WithConditions(values ...*VerticalPodAutoscalerRecommendationConditionApplyConfiguration) *VerticalPodAutoscalerRecommendationStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
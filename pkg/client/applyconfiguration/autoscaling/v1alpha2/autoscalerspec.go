@@ -0,0 +1,84 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	v2 "k8s.io/client-go/applyconfigurations/autoscaling/v2"
+)
+
+// AutoscalerSpecApplyConfiguration represents an declarative configuration of the AutoscalerSpec type for use
+// with apply.
+type AutoscalerSpecApplyConfiguration struct {
+	ScaleTargetRef *v2.CrossVersionObjectReferenceApplyConfiguration     `json:"scaleTargetRef,omitempty"`
+	Behavior       *v2.HorizontalPodAutoscalerBehaviorApplyConfiguration `json:"behavior,omitempty"`
+	Metrics        []MetricSpecApplyConfiguration                        `json:"metrics,omitempty"`
+	MinReplicas    *int32                                                `json:"minReplicas,omitempty"`
+	MaxReplicas    *int32                                                `json:"maxReplicas,omitempty"`
+}
+
+// AutoscalerSpecApplyConfiguration constructs an declarative configuration of the AutoscalerSpec type for use with
+// apply.
+func AutoscalerSpec() *AutoscalerSpecApplyConfiguration {
+	return &AutoscalerSpecApplyConfiguration{}
+}
+
+// WithScaleTargetRef sets the ScaleTargetRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ScaleTargetRef field is set to the value of the last call.
+func (b *AutoscalerSpecApplyConfiguration) WithScaleTargetRef(value *v2.CrossVersionObjectReferenceApplyConfiguration) *AutoscalerSpecApplyConfiguration {
+	b.ScaleTargetRef = value
+	return b
+}
+
+// WithBehavior sets the Behavior field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Behavior field is set to the value of the last call.
+func (b *AutoscalerSpecApplyConfiguration) WithBehavior(value *v2.HorizontalPodAutoscalerBehaviorApplyConfiguration) *AutoscalerSpecApplyConfiguration {
+	b.Behavior = value
+	return b
+}
+
+// WithMetrics adds the given value to the Metrics field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Metrics field.
+func (b *AutoscalerSpecApplyConfiguration) WithMetrics(values ...*MetricSpecApplyConfiguration) *AutoscalerSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithMetrics")
+		}
+		b.Metrics = append(b.Metrics, *values[i])
+	}
+	return b
+}
+
+// WithMinReplicas sets the MinReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MinReplicas field is set to the value of the last call.
+func (b *AutoscalerSpecApplyConfiguration) WithMinReplicas(value int32) *AutoscalerSpecApplyConfiguration {
+	b.MinReplicas = &value
+	return b
+}
+
+// WithMaxReplicas sets the MaxReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxReplicas field is set to the value of the last call.
+func (b *AutoscalerSpecApplyConfiguration) WithMaxReplicas(value int32) *AutoscalerSpecApplyConfiguration {
+	b.MaxReplicas = &value
+	return b
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha2
+
+// BoundApplyConfiguration represents an declarative configuration of the Bound type for use
+// with apply.
+type BoundApplyConfiguration struct {
+	CronTab     *string `json:"cronTab,omitempty"`
+	MinReplicas *int32  `json:"minReplicas,omitempty"`
+	MaxReplicas *int32  `json:"maxReplicas,omitempty"`
+}
+
+// BoundApplyConfiguration constructs an declarative configuration of the Bound type for use with
+// apply.
+func Bound() *BoundApplyConfiguration {
+	return &BoundApplyConfiguration{}
+}
+
+// WithCronTab sets the CronTab field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CronTab field is set to the value of the last call.
+func (b *BoundApplyConfiguration) WithCronTab(value string) *BoundApplyConfiguration {
+	b.CronTab = &value
+	return b
+}
+
+// WithMinReplicas sets the MinReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MinReplicas field is set to the value of the last call.
+func (b *BoundApplyConfiguration) WithMinReplicas(value int32) *BoundApplyConfiguration {
+	b.MinReplicas = &value
+	return b
+}
+
+// WithMaxReplicas sets the MaxReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxReplicas field is set to the value of the last call.
+func (b *BoundApplyConfiguration) WithMaxReplicas(value int32) *BoundApplyConfiguration {
+	b.MaxReplicas = &value
+	return b
+}
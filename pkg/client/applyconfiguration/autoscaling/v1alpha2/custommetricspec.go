@@ -0,0 +1,62 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	v1 "k8s.io/api/core/v1"
+	resource "k8s.io/apimachinery/pkg/api/resource"
+)
+
+// CustomMetricSpecApplyConfiguration represents an declarative configuration of the CustomMetricSpec type for use
+// with apply.
+type CustomMetricSpecApplyConfiguration struct {
+	Identify *v1.ResourceName   `json:"identify,omitempty"`
+	Query    *string            `json:"query,omitempty"`
+	Value    *resource.Quantity `json:"value,omitempty"`
+}
+
+// CustomMetricSpecApplyConfiguration constructs an declarative configuration of the CustomMetricSpec type for use with
+// apply.
+func CustomMetricSpec() *CustomMetricSpecApplyConfiguration {
+	return &CustomMetricSpecApplyConfiguration{}
+}
+
+// WithIdentify sets the Identify field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Identify field is set to the value of the last call.
+func (b *CustomMetricSpecApplyConfiguration) WithIdentify(value v1.ResourceName) *CustomMetricSpecApplyConfiguration {
+	b.Identify = &value
+	return b
+}
+
+// WithQuery sets the Query field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Query field is set to the value of the last call.
+func (b *CustomMetricSpecApplyConfiguration) WithQuery(value string) *CustomMetricSpecApplyConfiguration {
+	b.Query = &value
+	return b
+}
+
+// WithValue sets the Value field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Value field is set to the value of the last call.
+func (b *CustomMetricSpecApplyConfiguration) WithValue(value resource.Quantity) *CustomMetricSpecApplyConfiguration {
+	b.Value = &value
+	return b
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	autoscalingv1alpha2 "github.com/kubewharf/katalyst-api/pkg/apis/autoscaling/v1alpha2"
+	v1alpha1 "github.com/kubewharf/katalyst-api/pkg/client/applyconfiguration/config/v1alpha1"
+)
+
+// IntelligentHorizontalPodAutoscalerSpecApplyConfiguration represents an declarative configuration of the IntelligentHorizontalPodAutoscalerSpec type for use
+// with apply.
+type IntelligentHorizontalPodAutoscalerSpecApplyConfiguration struct {
+	Autoscaler      *AutoscalerSpecApplyConfiguration           `json:"autoscaler,omitempty"`
+	ScaleStrategy   *autoscalingv1alpha2.ScaleStrategyType      `json:"scaleStrategy,omitempty"`
+	AlgorithmConfig *v1alpha1.AlgorithmConfigApplyConfiguration `json:"algorithmConfig,omitempty"`
+	TimeBounds      []TimeBoundApplyConfiguration               `json:"timeBounds,omitempty"`
+}
+
+// IntelligentHorizontalPodAutoscalerSpecApplyConfiguration constructs an declarative configuration of the IntelligentHorizontalPodAutoscalerSpec type for use with
+// apply.
+func IntelligentHorizontalPodAutoscalerSpec() *IntelligentHorizontalPodAutoscalerSpecApplyConfiguration {
+	return &IntelligentHorizontalPodAutoscalerSpecApplyConfiguration{}
+}
+
+// WithAutoscaler sets the Autoscaler field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Autoscaler field is set to the value of the last call.
+func (b *IntelligentHorizontalPodAutoscalerSpecApplyConfiguration) WithAutoscaler(value *AutoscalerSpecApplyConfiguration) *IntelligentHorizontalPodAutoscalerSpecApplyConfiguration {
+	b.Autoscaler = value
+	return b
+}
+
+// WithScaleStrategy sets the ScaleStrategy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ScaleStrategy field is set to the value of the last call.
+func (b *IntelligentHorizontalPodAutoscalerSpecApplyConfiguration) WithScaleStrategy(value autoscalingv1alpha2.ScaleStrategyType) *IntelligentHorizontalPodAutoscalerSpecApplyConfiguration {
+	b.ScaleStrategy = &value
+	return b
+}
+
+// WithAlgorithmConfig sets the AlgorithmConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AlgorithmConfig field is set to the value of the last call.
+func (b *IntelligentHorizontalPodAutoscalerSpecApplyConfiguration) WithAlgorithmConfig(value *v1alpha1.AlgorithmConfigApplyConfiguration) *IntelligentHorizontalPodAutoscalerSpecApplyConfiguration {
+	b.AlgorithmConfig = value
+	return b
+}
+
+// WithTimeBounds adds the given value to the TimeBounds field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the TimeBounds field.
+func (b *IntelligentHorizontalPodAutoscalerSpecApplyConfiguration) WithTimeBounds(values ...*TimeBoundApplyConfiguration) *IntelligentHorizontalPodAutoscalerSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithTimeBounds")
+		}
+		b.TimeBounds = append(b.TimeBounds, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v2 "k8s.io/client-go/applyconfigurations/autoscaling/v2"
+)
+
+// IntelligentHorizontalPodAutoscalerStatusApplyConfiguration represents an declarative configuration of the IntelligentHorizontalPodAutoscalerStatus type for use
+// with apply.
+type IntelligentHorizontalPodAutoscalerStatusApplyConfiguration struct {
+	LastScaleTime   *v1.Time                            `json:"lastScaleTime,omitempty"`
+	CurrentMetrics  []v2.MetricStatusApplyConfiguration `json:"currentMetrics,omitempty"`
+	CurrentReplicas *int32                              `json:"currentReplicas,omitempty"`
+	DesiredReplicas *int32                              `json:"desiredReplicas,omitempty"`
+}
+
+// IntelligentHorizontalPodAutoscalerStatusApplyConfiguration constructs an declarative configuration of the IntelligentHorizontalPodAutoscalerStatus type for use with
+// apply.
+func IntelligentHorizontalPodAutoscalerStatus() *IntelligentHorizontalPodAutoscalerStatusApplyConfiguration {
+	return &IntelligentHorizontalPodAutoscalerStatusApplyConfiguration{}
+}
+
+// WithLastScaleTime sets the LastScaleTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastScaleTime field is set to the value of the last call.
+func (b *IntelligentHorizontalPodAutoscalerStatusApplyConfiguration) WithLastScaleTime(value v1.Time) *IntelligentHorizontalPodAutoscalerStatusApplyConfiguration {
+	b.LastScaleTime = &value
+	return b
+}
+
+// WithCurrentMetrics adds the given value to the CurrentMetrics field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the CurrentMetrics field.
+func (b *IntelligentHorizontalPodAutoscalerStatusApplyConfiguration) WithCurrentMetrics(values ...*v2.MetricStatusApplyConfiguration) *IntelligentHorizontalPodAutoscalerStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithCurrentMetrics")
+		}
+		b.CurrentMetrics = append(b.CurrentMetrics, *values[i])
+	}
+	return b
+}
+
+// WithCurrentReplicas sets the CurrentReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CurrentReplicas field is set to the value of the last call.
+func (b *IntelligentHorizontalPodAutoscalerStatusApplyConfiguration) WithCurrentReplicas(value int32) *IntelligentHorizontalPodAutoscalerStatusApplyConfiguration {
+	b.CurrentReplicas = &value
+	return b
+}
+
+// WithDesiredReplicas sets the DesiredReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DesiredReplicas field is set to the value of the last call.
+func (b *IntelligentHorizontalPodAutoscalerStatusApplyConfiguration) WithDesiredReplicas(value int32) *IntelligentHorizontalPodAutoscalerStatusApplyConfiguration {
+	b.DesiredReplicas = &value
+	return b
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	v1alpha1 "github.com/kubewharf/katalyst-api/pkg/client/applyconfiguration/recommendation/v1alpha1"
+)
+
+// KatalystVerticalPodAutoscalerSpecApplyConfiguration represents an declarative configuration of the KatalystVerticalPodAutoscalerSpec type for use
+// with apply.
+type KatalystVerticalPodAutoscalerSpecApplyConfiguration struct {
+	TargetRef      *v1alpha1.CrossVersionObjectReferenceApplyConfiguration `json:"targetRef,omitempty"`
+	ResourcePolicy *v1alpha1.ResourcePolicyApplyConfiguration              `json:"resourcePolicy,omitempty"`
+	UpdatePolicy   *PodUpdatePolicyApplyConfiguration                      `json:"updatePolicy,omitempty"`
+}
+
+// KatalystVerticalPodAutoscalerSpecApplyConfiguration constructs an declarative configuration of the KatalystVerticalPodAutoscalerSpec type for use with
+// apply.
+func KatalystVerticalPodAutoscalerSpec() *KatalystVerticalPodAutoscalerSpecApplyConfiguration {
+	return &KatalystVerticalPodAutoscalerSpecApplyConfiguration{}
+}
+
+// WithTargetRef sets the TargetRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TargetRef field is set to the value of the last call.
+func (b *KatalystVerticalPodAutoscalerSpecApplyConfiguration) WithTargetRef(value *v1alpha1.CrossVersionObjectReferenceApplyConfiguration) *KatalystVerticalPodAutoscalerSpecApplyConfiguration {
+	b.TargetRef = value
+	return b
+}
+
+// WithResourcePolicy sets the ResourcePolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResourcePolicy field is set to the value of the last call.
+func (b *KatalystVerticalPodAutoscalerSpecApplyConfiguration) WithResourcePolicy(value *v1alpha1.ResourcePolicyApplyConfiguration) *KatalystVerticalPodAutoscalerSpecApplyConfiguration {
+	b.ResourcePolicy = value
+	return b
+}
+
+// WithUpdatePolicy sets the UpdatePolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UpdatePolicy field is set to the value of the last call.
+func (b *KatalystVerticalPodAutoscalerSpecApplyConfiguration) WithUpdatePolicy(value *PodUpdatePolicyApplyConfiguration) *KatalystVerticalPodAutoscalerSpecApplyConfiguration {
+	b.UpdatePolicy = value
+	return b
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	v2 "k8s.io/client-go/applyconfigurations/autoscaling/v2"
+)
+
+// MetricSpecApplyConfiguration represents an declarative configuration of the MetricSpec type for use
+// with apply.
+type MetricSpecApplyConfiguration struct {
+	Metric       *v2.MetricSpecApplyConfiguration    `json:"metric,omitempty"`
+	CustomMetric *CustomMetricSpecApplyConfiguration `json:"customMetric,omitempty"`
+}
+
+// MetricSpecApplyConfiguration constructs an declarative configuration of the MetricSpec type for use with
+// apply.
+func MetricSpec() *MetricSpecApplyConfiguration {
+	return &MetricSpecApplyConfiguration{}
+}
+
+// WithMetric sets the Metric field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Metric field is set to the value of the last call.
+func (b *MetricSpecApplyConfiguration) WithMetric(value *v2.MetricSpecApplyConfiguration) *MetricSpecApplyConfiguration {
+	b.Metric = value
+	return b
+}
+
+// WithCustomMetric sets the CustomMetric field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CustomMetric field is set to the value of the last call.
+func (b *MetricSpecApplyConfiguration) WithCustomMetric(value *CustomMetricSpecApplyConfiguration) *MetricSpecApplyConfiguration {
+	b.CustomMetric = value
+	return b
+}
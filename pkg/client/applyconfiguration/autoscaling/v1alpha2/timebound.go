@@ -0,0 +1,66 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TimeBoundApplyConfiguration represents an declarative configuration of the TimeBound type for use
+// with apply.
+type TimeBoundApplyConfiguration struct {
+	Start  *v1.Time                  `json:"start,omitempty"`
+	End    *v1.Time                  `json:"end,omitempty"`
+	Bounds []BoundApplyConfiguration `json:"bounds,omitempty"`
+}
+
+// TimeBoundApplyConfiguration constructs an declarative configuration of the TimeBound type for use with
+// apply.
+func TimeBound() *TimeBoundApplyConfiguration {
+	return &TimeBoundApplyConfiguration{}
+}
+
+// WithStart sets the Start field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Start field is set to the value of the last call.
+func (b *TimeBoundApplyConfiguration) WithStart(value v1.Time) *TimeBoundApplyConfiguration {
+	b.Start = &value
+	return b
+}
+
+// WithEnd sets the End field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the End field is set to the value of the last call.
+func (b *TimeBoundApplyConfiguration) WithEnd(value v1.Time) *TimeBoundApplyConfiguration {
+	b.End = &value
+	return b
+}
+
+// WithBounds adds the given value to the Bounds field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Bounds field.
+func (b *TimeBoundApplyConfiguration) WithBounds(values ...*BoundApplyConfiguration) *TimeBoundApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithBounds")
+		}
+		b.Bounds = append(b.Bounds, *values[i])
+	}
+	return b
+}
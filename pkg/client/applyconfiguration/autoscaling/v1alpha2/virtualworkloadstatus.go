@@ -0,0 +1,48 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha2
+
+// VirtualWorkloadStatusApplyConfiguration represents an declarative configuration of the VirtualWorkloadStatus type for use
+// with apply.
+type VirtualWorkloadStatusApplyConfiguration struct {
+	Replicas *int32  `json:"replicas,omitempty"`
+	Selector *string `json:"selector,omitempty"`
+}
+
+// VirtualWorkloadStatusApplyConfiguration constructs an declarative configuration of the VirtualWorkloadStatus type for use with
+// apply.
+func VirtualWorkloadStatus() *VirtualWorkloadStatusApplyConfiguration {
+	return &VirtualWorkloadStatusApplyConfiguration{}
+}
+
+// WithReplicas sets the Replicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replicas field is set to the value of the last call.
+func (b *VirtualWorkloadStatusApplyConfiguration) WithReplicas(value int32) *VirtualWorkloadStatusApplyConfiguration {
+	b.Replicas = &value
+	return b
+}
+
+// WithSelector sets the Selector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Selector field is set to the value of the last call.
+func (b *VirtualWorkloadStatusApplyConfiguration) WithSelector(value string) *VirtualWorkloadStatusApplyConfiguration {
+	b.Selector = &value
+	return b
+}
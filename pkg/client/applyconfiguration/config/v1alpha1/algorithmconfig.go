@@ -0,0 +1,76 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	time "time"
+)
+
+// AlgorithmConfigApplyConfiguration represents an declarative configuration of the AlgorithmConfig type for use
+// with apply.
+type AlgorithmConfigApplyConfiguration struct {
+	Method       *string                       `json:"method,omitempty"`
+	Params       map[string]string             `json:"params,omitempty"`
+	TimeWindow   *TimeWindowApplyConfiguration `json:"timeWindow,omitempty"`
+	ResyncPeriod *time.Duration                `json:"resyncPeriod,omitempty"`
+}
+
+// AlgorithmConfigApplyConfiguration constructs an declarative configuration of the AlgorithmConfig type for use with
+// apply.
+func AlgorithmConfig() *AlgorithmConfigApplyConfiguration {
+	return &AlgorithmConfigApplyConfiguration{}
+}
+
+// WithMethod sets the Method field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Method field is set to the value of the last call.
+func (b *AlgorithmConfigApplyConfiguration) WithMethod(value string) *AlgorithmConfigApplyConfiguration {
+	b.Method = &value
+	return b
+}
+
+// WithParams puts the entries into the Params field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Params field,
+// overwriting an existing map entries in Params field with the same key.
+func (b *AlgorithmConfigApplyConfiguration) WithParams(entries map[string]string) *AlgorithmConfigApplyConfiguration {
+	if b.Params == nil && len(entries) > 0 {
+		b.Params = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.Params[k] = v
+	}
+	return b
+}
+
+// WithTimeWindow sets the TimeWindow field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TimeWindow field is set to the value of the last call.
+func (b *AlgorithmConfigApplyConfiguration) WithTimeWindow(value *TimeWindowApplyConfiguration) *AlgorithmConfigApplyConfiguration {
+	b.TimeWindow = value
+	return b
+}
+
+// WithResyncPeriod sets the ResyncPeriod field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResyncPeriod field is set to the value of the last call.
+func (b *AlgorithmConfigApplyConfiguration) WithResyncPeriod(value time.Duration) *AlgorithmConfigApplyConfiguration {
+	b.ResyncPeriod = &value
+	return b
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/config/v1alpha1"
+)
+
+// ConfigUpdateStrategyApplyConfiguration represents an declarative configuration of the ConfigUpdateStrategy type for use
+// with apply.
+type ConfigUpdateStrategyApplyConfiguration struct {
+	Type          *v1alpha1.ConfigUpdateStrategyType     `json:"type,omitempty"`
+	RollingUpdate *RollingUpdateConfigApplyConfiguration `json:"rollingUpdate,omitempty"`
+}
+
+// ConfigUpdateStrategyApplyConfiguration constructs an declarative configuration of the ConfigUpdateStrategy type for use with
+// apply.
+func ConfigUpdateStrategy() *ConfigUpdateStrategyApplyConfiguration {
+	return &ConfigUpdateStrategyApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *ConfigUpdateStrategyApplyConfiguration) WithType(value v1alpha1.ConfigUpdateStrategyType) *ConfigUpdateStrategyApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithRollingUpdate sets the RollingUpdate field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RollingUpdate field is set to the value of the last call.
+func (b *ConfigUpdateStrategyApplyConfiguration) WithRollingUpdate(value *RollingUpdateConfigApplyConfiguration) *ConfigUpdateStrategyApplyConfiguration {
+	b.RollingUpdate = value
+	return b
+}
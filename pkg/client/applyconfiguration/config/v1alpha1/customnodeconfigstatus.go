@@ -0,0 +1,58 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// CustomNodeConfigStatusApplyConfiguration represents an declarative configuration of the CustomNodeConfigStatus type for use
+// with apply.
+type CustomNodeConfigStatusApplyConfiguration struct {
+	KatalystCustomConfigList []TargetConfigApplyConfiguration `json:"katalystCustomConfigList,omitempty"`
+	ServiceProfileConfigList []TargetConfigApplyConfiguration `json:"serviceProfileConfigList,omitempty"`
+}
+
+// CustomNodeConfigStatusApplyConfiguration constructs an declarative configuration of the CustomNodeConfigStatus type for use with
+// apply.
+func CustomNodeConfigStatus() *CustomNodeConfigStatusApplyConfiguration {
+	return &CustomNodeConfigStatusApplyConfiguration{}
+}
+
+// WithKatalystCustomConfigList adds the given value to the KatalystCustomConfigList field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the KatalystCustomConfigList field.
+func (b *CustomNodeConfigStatusApplyConfiguration) WithKatalystCustomConfigList(values ...*TargetConfigApplyConfiguration) *CustomNodeConfigStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithKatalystCustomConfigList")
+		}
+		b.KatalystCustomConfigList = append(b.KatalystCustomConfigList, *values[i])
+	}
+	return b
+}
+
+// WithServiceProfileConfigList adds the given value to the ServiceProfileConfigList field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ServiceProfileConfigList field.
+func (b *CustomNodeConfigStatusApplyConfiguration) WithServiceProfileConfigList(values ...*TargetConfigApplyConfiguration) *CustomNodeConfigStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithServiceProfileConfigList")
+		}
+		b.ServiceProfileConfigList = append(b.ServiceProfileConfigList, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EphemeralSelectorApplyConfiguration represents an declarative configuration of the EphemeralSelector type for use
+// with apply.
+type EphemeralSelectorApplyConfiguration struct {
+	NodeNames    []string     `json:"nodeNames,omitempty"`
+	LastDuration *v1.Duration `json:"lastDuration,omitempty"`
+}
+
+// EphemeralSelectorApplyConfiguration constructs an declarative configuration of the EphemeralSelector type for use with
+// apply.
+func EphemeralSelector() *EphemeralSelectorApplyConfiguration {
+	return &EphemeralSelectorApplyConfiguration{}
+}
+
+// WithNodeNames adds the given value to the NodeNames field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the NodeNames field.
+func (b *EphemeralSelectorApplyConfiguration) WithNodeNames(values ...string) *EphemeralSelectorApplyConfiguration {
+	for i := range values {
+		b.NodeNames = append(b.NodeNames, values[i])
+	}
+	return b
+}
+
+// WithLastDuration sets the LastDuration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastDuration field is set to the value of the last call.
+func (b *EphemeralSelectorApplyConfiguration) WithLastDuration(value v1.Duration) *EphemeralSelectorApplyConfiguration {
+	b.LastDuration = &value
+	return b
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// GenericConfigSpecApplyConfiguration represents an declarative configuration of the GenericConfigSpec type for use
+// with apply.
+type GenericConfigSpecApplyConfiguration struct {
+	RevisionHistoryLimit *int64                                  `json:"revisionHistoryLimit,omitempty"`
+	NodeLabelSelector    *string                                 `json:"nodeLabelSelector,omitempty"`
+	Priority             *int32                                  `json:"priority,omitempty"`
+	EphemeralSelector    *EphemeralSelectorApplyConfiguration    `json:"ephemeralSelector,omitempty"`
+	Paused               *bool                                   `json:"paused,omitempty"`
+	UpdateStrategy       *ConfigUpdateStrategyApplyConfiguration `json:"updateStrategy,omitempty"`
+}
+
+// GenericConfigSpecApplyConfiguration constructs an declarative configuration of the GenericConfigSpec type for use with
+// apply.
+func GenericConfigSpec() *GenericConfigSpecApplyConfiguration {
+	return &GenericConfigSpecApplyConfiguration{}
+}
+
+// WithRevisionHistoryLimit sets the RevisionHistoryLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RevisionHistoryLimit field is set to the value of the last call.
+func (b *GenericConfigSpecApplyConfiguration) WithRevisionHistoryLimit(value int64) *GenericConfigSpecApplyConfiguration {
+	b.RevisionHistoryLimit = &value
+	return b
+}
+
+// WithNodeLabelSelector sets the NodeLabelSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NodeLabelSelector field is set to the value of the last call.
+func (b *GenericConfigSpecApplyConfiguration) WithNodeLabelSelector(value string) *GenericConfigSpecApplyConfiguration {
+	b.NodeLabelSelector = &value
+	return b
+}
+
+// WithPriority sets the Priority field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Priority field is set to the value of the last call.
+func (b *GenericConfigSpecApplyConfiguration) WithPriority(value int32) *GenericConfigSpecApplyConfiguration {
+	b.Priority = &value
+	return b
+}
+
+// WithEphemeralSelector sets the EphemeralSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EphemeralSelector field is set to the value of the last call.
+func (b *GenericConfigSpecApplyConfiguration) WithEphemeralSelector(value *EphemeralSelectorApplyConfiguration) *GenericConfigSpecApplyConfiguration {
+	b.EphemeralSelector = value
+	return b
+}
+
+// WithPaused sets the Paused field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Paused field is set to the value of the last call.
+func (b *GenericConfigSpecApplyConfiguration) WithPaused(value bool) *GenericConfigSpecApplyConfiguration {
+	b.Paused = &value
+	return b
+}
+
+// WithUpdateStrategy sets the UpdateStrategy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UpdateStrategy field is set to the value of the last call.
+func (b *GenericConfigSpecApplyConfiguration) WithUpdateStrategy(value *ConfigUpdateStrategyApplyConfiguration) *GenericConfigSpecApplyConfiguration {
+	b.UpdateStrategy = value
+	return b
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// GroupConfigApplyConfiguration represents an declarative configuration of the GroupConfig type for use
+// with apply.
+type GroupConfigApplyConfiguration struct {
+	Ratio             *float64                     `json:"ratio,omitempty"`
+	EnabledStrategies []StrategyApplyConfiguration `json:"enabledStrategies,omitempty"`
+}
+
+// GroupConfigApplyConfiguration constructs an declarative configuration of the GroupConfig type for use with
+// apply.
+func GroupConfig() *GroupConfigApplyConfiguration {
+	return &GroupConfigApplyConfiguration{}
+}
+
+// WithRatio sets the Ratio field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Ratio field is set to the value of the last call.
+func (b *GroupConfigApplyConfiguration) WithRatio(value float64) *GroupConfigApplyConfiguration {
+	b.Ratio = &value
+	return b
+}
+
+// WithEnabledStrategies adds the given value to the EnabledStrategies field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the EnabledStrategies field.
+func (b *GroupConfigApplyConfiguration) WithEnabledStrategies(values ...*StrategyApplyConfiguration) *GroupConfigApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithEnabledStrategies")
+		}
+		b.EnabledStrategies = append(b.EnabledStrategies, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// KatalystCustomConfigSpecApplyConfiguration represents an declarative configuration of the KatalystCustomConfigSpec type for use
+// with apply.
+type KatalystCustomConfigSpecApplyConfiguration struct {
+	TargetType                      *v1.GroupVersionResource                                    `json:"targetType,omitempty"`
+	DisableRevisionHistory          *bool                                                       `json:"disableRevisionHistory,omitempty"`
+	NodeLabelSelectorAllowedKeyList []PriorityNodeLabelSelectorAllowedKeyListApplyConfiguration `json:"nodeLabelSelectorAllowedKeyList,omitempty"`
+}
+
+// KatalystCustomConfigSpecApplyConfiguration constructs an declarative configuration of the KatalystCustomConfigSpec type for use with
+// apply.
+func KatalystCustomConfigSpec() *KatalystCustomConfigSpecApplyConfiguration {
+	return &KatalystCustomConfigSpecApplyConfiguration{}
+}
+
+// WithTargetType sets the TargetType field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TargetType field is set to the value of the last call.
+func (b *KatalystCustomConfigSpecApplyConfiguration) WithTargetType(value v1.GroupVersionResource) *KatalystCustomConfigSpecApplyConfiguration {
+	b.TargetType = &value
+	return b
+}
+
+// WithDisableRevisionHistory sets the DisableRevisionHistory field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DisableRevisionHistory field is set to the value of the last call.
+func (b *KatalystCustomConfigSpecApplyConfiguration) WithDisableRevisionHistory(value bool) *KatalystCustomConfigSpecApplyConfiguration {
+	b.DisableRevisionHistory = &value
+	return b
+}
+
+// WithNodeLabelSelectorAllowedKeyList adds the given value to the NodeLabelSelectorAllowedKeyList field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the NodeLabelSelectorAllowedKeyList field.
+func (b *KatalystCustomConfigSpecApplyConfiguration) WithNodeLabelSelectorAllowedKeyList(values ...*PriorityNodeLabelSelectorAllowedKeyListApplyConfiguration) *KatalystCustomConfigSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithNodeLabelSelectorAllowedKeyList")
+		}
+		b.NodeLabelSelectorAllowedKeyList = append(b.NodeLabelSelectorAllowedKeyList, *values[i])
+	}
+	return b
+}
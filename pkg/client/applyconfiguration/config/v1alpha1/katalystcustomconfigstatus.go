@@ -0,0 +1,64 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// KatalystCustomConfigStatusApplyConfiguration represents an declarative configuration of the KatalystCustomConfigStatus type for use
+// with apply.
+type KatalystCustomConfigStatusApplyConfiguration struct {
+	ObservedGeneration      *int64                                            `json:"observedGeneration,omitempty"`
+	InvalidTargetConfigList []string                                          `json:"invalidTargetConfigList,omitempty"`
+	Conditions              []KatalystCustomConfigConditionApplyConfiguration `json:"conditions,omitempty"`
+}
+
+// KatalystCustomConfigStatusApplyConfiguration constructs an declarative configuration of the KatalystCustomConfigStatus type for use with
+// apply.
+func KatalystCustomConfigStatus() *KatalystCustomConfigStatusApplyConfiguration {
+	return &KatalystCustomConfigStatusApplyConfiguration{}
+}
+
+// WithObservedGeneration sets the ObservedGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObservedGeneration field is set to the value of the last call.
+func (b *KatalystCustomConfigStatusApplyConfiguration) WithObservedGeneration(value int64) *KatalystCustomConfigStatusApplyConfiguration {
+	b.ObservedGeneration = &value
+	return b
+}
+
+// WithInvalidTargetConfigList adds the given value to the InvalidTargetConfigList field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the InvalidTargetConfigList field.
+func (b *KatalystCustomConfigStatusApplyConfiguration) WithInvalidTargetConfigList(values ...string) *KatalystCustomConfigStatusApplyConfiguration {
+	for i := range values {
+		b.InvalidTargetConfigList = append(b.InvalidTargetConfigList, values[i])
+	}
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *KatalystCustomConfigStatusApplyConfiguration) WithConditions(values ...*KatalystCustomConfigConditionApplyConfiguration) *KatalystCustomConfigStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
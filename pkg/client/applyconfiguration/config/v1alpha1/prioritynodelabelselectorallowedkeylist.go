@@ -0,0 +1,50 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// PriorityNodeLabelSelectorAllowedKeyListApplyConfiguration represents an declarative configuration of the PriorityNodeLabelSelectorAllowedKeyList type for use
+// with apply.
+type PriorityNodeLabelSelectorAllowedKeyListApplyConfiguration struct {
+	Priority *int32   `json:"priority,omitempty"`
+	KeyList  []string `json:"keyList,omitempty"`
+}
+
+// PriorityNodeLabelSelectorAllowedKeyListApplyConfiguration constructs an declarative configuration of the PriorityNodeLabelSelectorAllowedKeyList type for use with
+// apply.
+func PriorityNodeLabelSelectorAllowedKeyList() *PriorityNodeLabelSelectorAllowedKeyListApplyConfiguration {
+	return &PriorityNodeLabelSelectorAllowedKeyListApplyConfiguration{}
+}
+
+// WithPriority sets the Priority field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Priority field is set to the value of the last call.
+func (b *PriorityNodeLabelSelectorAllowedKeyListApplyConfiguration) WithPriority(value int32) *PriorityNodeLabelSelectorAllowedKeyListApplyConfiguration {
+	b.Priority = &value
+	return b
+}
+
+// WithKeyList adds the given value to the KeyList field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the KeyList field.
+func (b *PriorityNodeLabelSelectorAllowedKeyListApplyConfiguration) WithKeyList(values ...string) *PriorityNodeLabelSelectorAllowedKeyListApplyConfiguration {
+	for i := range values {
+		b.KeyList = append(b.KeyList, values[i])
+	}
+	return b
+}
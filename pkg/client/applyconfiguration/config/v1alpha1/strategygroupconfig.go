@@ -0,0 +1,44 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// StrategyGroupConfigApplyConfiguration represents an declarative configuration of the StrategyGroupConfig type for use
+// with apply.
+type StrategyGroupConfigApplyConfiguration struct {
+	GroupConfigs []GroupConfigApplyConfiguration `json:"groupConfigs,omitempty"`
+}
+
+// StrategyGroupConfigApplyConfiguration constructs an declarative configuration of the StrategyGroupConfig type for use with
+// apply.
+func StrategyGroupConfig() *StrategyGroupConfigApplyConfiguration {
+	return &StrategyGroupConfigApplyConfiguration{}
+}
+
+// WithGroupConfigs adds the given value to the GroupConfigs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the GroupConfigs field.
+func (b *StrategyGroupConfigApplyConfiguration) WithGroupConfigs(values ...*GroupConfigApplyConfiguration) *StrategyGroupConfigApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithGroupConfigs")
+		}
+		b.GroupConfigs = append(b.GroupConfigs, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// StrategyGroupConfigurationSpecApplyConfiguration represents an declarative configuration of the StrategyGroupConfigurationSpec type for use
+// with apply.
+type StrategyGroupConfigurationSpecApplyConfiguration struct {
+	GenericConfigSpecApplyConfiguration `json:",inline"`
+	Config                              *StrategyGroupConfigApplyConfiguration `json:"config,omitempty"`
+}
+
+// StrategyGroupConfigurationSpecApplyConfiguration constructs an declarative configuration of the StrategyGroupConfigurationSpec type for use with
+// apply.
+func StrategyGroupConfigurationSpec() *StrategyGroupConfigurationSpecApplyConfiguration {
+	return &StrategyGroupConfigurationSpecApplyConfiguration{}
+}
+
+// WithRevisionHistoryLimit sets the RevisionHistoryLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RevisionHistoryLimit field is set to the value of the last call.
+func (b *StrategyGroupConfigurationSpecApplyConfiguration) WithRevisionHistoryLimit(value int64) *StrategyGroupConfigurationSpecApplyConfiguration {
+	b.RevisionHistoryLimit = &value
+	return b
+}
+
+// WithNodeLabelSelector sets the NodeLabelSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NodeLabelSelector field is set to the value of the last call.
+func (b *StrategyGroupConfigurationSpecApplyConfiguration) WithNodeLabelSelector(value string) *StrategyGroupConfigurationSpecApplyConfiguration {
+	b.NodeLabelSelector = &value
+	return b
+}
+
+// WithPriority sets the Priority field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Priority field is set to the value of the last call.
+func (b *StrategyGroupConfigurationSpecApplyConfiguration) WithPriority(value int32) *StrategyGroupConfigurationSpecApplyConfiguration {
+	b.Priority = &value
+	return b
+}
+
+// WithEphemeralSelector sets the EphemeralSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EphemeralSelector field is set to the value of the last call.
+func (b *StrategyGroupConfigurationSpecApplyConfiguration) WithEphemeralSelector(value *EphemeralSelectorApplyConfiguration) *StrategyGroupConfigurationSpecApplyConfiguration {
+	b.EphemeralSelector = value
+	return b
+}
+
+// WithPaused sets the Paused field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Paused field is set to the value of the last call.
+func (b *StrategyGroupConfigurationSpecApplyConfiguration) WithPaused(value bool) *StrategyGroupConfigurationSpecApplyConfiguration {
+	b.Paused = &value
+	return b
+}
+
+// WithUpdateStrategy sets the UpdateStrategy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UpdateStrategy field is set to the value of the last call.
+func (b *StrategyGroupConfigurationSpecApplyConfiguration) WithUpdateStrategy(value *ConfigUpdateStrategyApplyConfiguration) *StrategyGroupConfigurationSpecApplyConfiguration {
+	b.UpdateStrategy = value
+	return b
+}
+
+// WithConfig sets the Config field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Config field is set to the value of the last call.
+func (b *StrategyGroupConfigurationSpecApplyConfiguration) WithConfig(value *StrategyGroupConfigApplyConfiguration) *StrategyGroupConfigurationSpecApplyConfiguration {
+	b.Config = value
+	return b
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// StrategyGroupStatusApplyConfiguration represents an declarative configuration of the StrategyGroupStatus type for use
+// with apply.
+type StrategyGroupStatusApplyConfiguration struct {
+	GenericConfigStatusApplyConfiguration `json:",inline"`
+	EnabledStrategies                     []StrategyApplyConfiguration `json:"enabledStrategies,omitempty"`
+}
+
+// StrategyGroupStatusApplyConfiguration constructs an declarative configuration of the StrategyGroupStatus type for use with
+// apply.
+func StrategyGroupStatus() *StrategyGroupStatusApplyConfiguration {
+	return &StrategyGroupStatusApplyConfiguration{}
+}
+
+// WithTargetNodes sets the TargetNodes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TargetNodes field is set to the value of the last call.
+func (b *StrategyGroupStatusApplyConfiguration) WithTargetNodes(value int32) *StrategyGroupStatusApplyConfiguration {
+	b.TargetNodes = &value
+	return b
+}
+
+// WithCanaryNodes sets the CanaryNodes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CanaryNodes field is set to the value of the last call.
+func (b *StrategyGroupStatusApplyConfiguration) WithCanaryNodes(value int32) *StrategyGroupStatusApplyConfiguration {
+	b.CanaryNodes = &value
+	return b
+}
+
+// WithUpdatedTargetNodes sets the UpdatedTargetNodes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UpdatedTargetNodes field is set to the value of the last call.
+func (b *StrategyGroupStatusApplyConfiguration) WithUpdatedTargetNodes(value int32) *StrategyGroupStatusApplyConfiguration {
+	b.UpdatedTargetNodes = &value
+	return b
+}
+
+// WithUpdatedNodes sets the UpdatedNodes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UpdatedNodes field is set to the value of the last call.
+func (b *StrategyGroupStatusApplyConfiguration) WithUpdatedNodes(value int32) *StrategyGroupStatusApplyConfiguration {
+	b.UpdatedNodes = &value
+	return b
+}
+
+// WithCurrentHash sets the CurrentHash field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CurrentHash field is set to the value of the last call.
+func (b *StrategyGroupStatusApplyConfiguration) WithCurrentHash(value string) *StrategyGroupStatusApplyConfiguration {
+	b.CurrentHash = &value
+	return b
+}
+
+// WithCollisionCount sets the CollisionCount field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CollisionCount field is set to the value of the last call.
+func (b *StrategyGroupStatusApplyConfiguration) WithCollisionCount(value int32) *StrategyGroupStatusApplyConfiguration {
+	b.CollisionCount = &value
+	return b
+}
+
+// WithObservedGeneration sets the ObservedGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObservedGeneration field is set to the value of the last call.
+func (b *StrategyGroupStatusApplyConfiguration) WithObservedGeneration(value int64) *StrategyGroupStatusApplyConfiguration {
+	b.ObservedGeneration = &value
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *StrategyGroupStatusApplyConfiguration) WithConditions(values ...*GenericConfigConditionApplyConfiguration) *StrategyGroupStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
+
+// WithEnabledStrategies adds the given value to the EnabledStrategies field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the EnabledStrategies field.
+func (b *StrategyGroupStatusApplyConfiguration) WithEnabledStrategies(values ...*StrategyApplyConfiguration) *StrategyGroupStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithEnabledStrategies")
+		}
+		b.EnabledStrategies = append(b.EnabledStrategies, *values[i])
+	}
+	return b
+}
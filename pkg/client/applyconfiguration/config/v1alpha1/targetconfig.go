@@ -0,0 +1,70 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TargetConfigApplyConfiguration represents an declarative configuration of the TargetConfig type for use
+// with apply.
+type TargetConfigApplyConfiguration struct {
+	ConfigType      *v1.GroupVersionResource `json:"configType,omitempty"`
+	ConfigName      *string                  `json:"configName,omitempty"`
+	ConfigNamespace *string                  `json:"configNamespace,omitempty"`
+	Hash            *string                  `json:"hash,omitempty"`
+}
+
+// TargetConfigApplyConfiguration constructs an declarative configuration of the TargetConfig type for use with
+// apply.
+func TargetConfig() *TargetConfigApplyConfiguration {
+	return &TargetConfigApplyConfiguration{}
+}
+
+// WithConfigType sets the ConfigType field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConfigType field is set to the value of the last call.
+func (b *TargetConfigApplyConfiguration) WithConfigType(value v1.GroupVersionResource) *TargetConfigApplyConfiguration {
+	b.ConfigType = &value
+	return b
+}
+
+// WithConfigName sets the ConfigName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConfigName field is set to the value of the last call.
+func (b *TargetConfigApplyConfiguration) WithConfigName(value string) *TargetConfigApplyConfiguration {
+	b.ConfigName = &value
+	return b
+}
+
+// WithConfigNamespace sets the ConfigNamespace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConfigNamespace field is set to the value of the last call.
+func (b *TargetConfigApplyConfiguration) WithConfigNamespace(value string) *TargetConfigApplyConfiguration {
+	b.ConfigNamespace = &value
+	return b
+}
+
+// WithHash sets the Hash field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Hash field is set to the value of the last call.
+func (b *TargetConfigApplyConfiguration) WithHash(value string) *TargetConfigApplyConfiguration {
+	b.Hash = &value
+	return b
+}
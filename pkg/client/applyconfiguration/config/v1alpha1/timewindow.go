@@ -0,0 +1,79 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/config/v1alpha1"
+)
+
+// TimeWindowApplyConfiguration represents an declarative configuration of the TimeWindow type for use
+// with apply.
+type TimeWindowApplyConfiguration struct {
+	Input           *int                 `json:"input,omitempty"`
+	HistorySteps    *int                 `json:"historySteps,omitempty"`
+	Aggregator      *v1alpha1.Aggregator `json:"aggregator,omitempty"`
+	Output          *int                 `json:"output,omitempty"`
+	PredictionSteps *int                 `json:"predictionSteps,omitempty"`
+}
+
+// TimeWindowApplyConfiguration constructs an declarative configuration of the TimeWindow type for use with
+// apply.
+func TimeWindow() *TimeWindowApplyConfiguration {
+	return &TimeWindowApplyConfiguration{}
+}
+
+// WithInput sets the Input field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Input field is set to the value of the last call.
+func (b *TimeWindowApplyConfiguration) WithInput(value int) *TimeWindowApplyConfiguration {
+	b.Input = &value
+	return b
+}
+
+// WithHistorySteps sets the HistorySteps field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HistorySteps field is set to the value of the last call.
+func (b *TimeWindowApplyConfiguration) WithHistorySteps(value int) *TimeWindowApplyConfiguration {
+	b.HistorySteps = &value
+	return b
+}
+
+// WithAggregator sets the Aggregator field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Aggregator field is set to the value of the last call.
+func (b *TimeWindowApplyConfiguration) WithAggregator(value v1alpha1.Aggregator) *TimeWindowApplyConfiguration {
+	b.Aggregator = &value
+	return b
+}
+
+// WithOutput sets the Output field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Output field is set to the value of the last call.
+func (b *TimeWindowApplyConfiguration) WithOutput(value int) *TimeWindowApplyConfiguration {
+	b.Output = &value
+	return b
+}
+
+// WithPredictionSteps sets the PredictionSteps field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PredictionSteps field is set to the value of the last call.
+func (b *TimeWindowApplyConfiguration) WithPredictionSteps(value int) *TimeWindowApplyConfiguration {
+	b.PredictionSteps = &value
+	return b
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/node/v1alpha1"
+)
+
+// CustomNodeResourceSpecApplyConfiguration represents an declarative configuration of the CustomNodeResourceSpec type for use
+// with apply.
+type CustomNodeResourceSpecApplyConfiguration struct {
+	NodeResourceProperties []*v1alpha1.Property      `json:"nodeResourceProperties,omitempty"`
+	Taints                 []TaintApplyConfiguration `json:"taints,omitempty"`
+}
+
+// CustomNodeResourceSpecApplyConfiguration constructs an declarative configuration of the CustomNodeResourceSpec type for use with
+// apply.
+func CustomNodeResourceSpec() *CustomNodeResourceSpecApplyConfiguration {
+	return &CustomNodeResourceSpecApplyConfiguration{}
+}
+
+// WithNodeResourceProperties adds the given value to the NodeResourceProperties field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the NodeResourceProperties field.
+func (b *CustomNodeResourceSpecApplyConfiguration) WithNodeResourceProperties(values ...**v1alpha1.Property) *CustomNodeResourceSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithNodeResourceProperties")
+		}
+		b.NodeResourceProperties = append(b.NodeResourceProperties, *values[i])
+	}
+	return b
+}
+
+// WithTaints adds the given value to the Taints field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Taints field.
+func (b *CustomNodeResourceSpecApplyConfiguration) WithTaints(values ...*TaintApplyConfiguration) *CustomNodeResourceSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithTaints")
+		}
+		b.Taints = append(b.Taints, *values[i])
+	}
+	return b
+}
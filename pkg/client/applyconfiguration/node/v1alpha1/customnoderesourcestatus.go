@@ -0,0 +1,89 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	nodev1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/node/v1alpha1"
+)
+
+// CustomNodeResourceStatusApplyConfiguration represents an declarative configuration of the CustomNodeResourceStatus type for use
+// with apply.
+type CustomNodeResourceStatusApplyConfiguration struct {
+	Resources        *ResourcesApplyConfiguration        `json:"resources,omitempty"`
+	TopologyZone     []*nodev1alpha1.TopologyZone        `json:"topologyZone,omitempty"`
+	TopologyPolicy   *nodev1alpha1.TopologyPolicy        `json:"topologyPolicy,omitempty"`
+	Conditions       []CNRConditionApplyConfiguration    `json:"conditions,omitempty"`
+	NodeMetricStatus *NodeMetricStatusApplyConfiguration `json:"nodeMetricStatus,omitempty"`
+}
+
+// CustomNodeResourceStatusApplyConfiguration constructs an declarative configuration of the CustomNodeResourceStatus type for use with
+// apply.
+func CustomNodeResourceStatus() *CustomNodeResourceStatusApplyConfiguration {
+	return &CustomNodeResourceStatusApplyConfiguration{}
+}
+
+// WithResources sets the Resources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resources field is set to the value of the last call.
+func (b *CustomNodeResourceStatusApplyConfiguration) WithResources(value *ResourcesApplyConfiguration) *CustomNodeResourceStatusApplyConfiguration {
+	b.Resources = value
+	return b
+}
+
+// WithTopologyZone adds the given value to the TopologyZone field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the TopologyZone field.
+func (b *CustomNodeResourceStatusApplyConfiguration) WithTopologyZone(values ...**nodev1alpha1.TopologyZone) *CustomNodeResourceStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithTopologyZone")
+		}
+		b.TopologyZone = append(b.TopologyZone, *values[i])
+	}
+	return b
+}
+
+// WithTopologyPolicy sets the TopologyPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TopologyPolicy field is set to the value of the last call.
+func (b *CustomNodeResourceStatusApplyConfiguration) WithTopologyPolicy(value nodev1alpha1.TopologyPolicy) *CustomNodeResourceStatusApplyConfiguration {
+	b.TopologyPolicy = &value
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *CustomNodeResourceStatusApplyConfiguration) WithConditions(values ...*CNRConditionApplyConfiguration) *CustomNodeResourceStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
+
+// WithNodeMetricStatus sets the NodeMetricStatus field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NodeMetricStatus field is set to the value of the last call.
+func (b *CustomNodeResourceStatusApplyConfiguration) WithNodeMetricStatus(value *NodeMetricStatusApplyConfiguration) *CustomNodeResourceStatusApplyConfiguration {
+	b.NodeMetricStatus = value
+	return b
+}
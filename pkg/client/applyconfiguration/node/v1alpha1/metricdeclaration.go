@@ -0,0 +1,73 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/node/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MetricDeclarationApplyConfiguration represents an declarative configuration of the MetricDeclaration type for use
+// with apply.
+type MetricDeclarationApplyConfiguration struct {
+	MetricName    *string               `json:"metricName,omitempty"`
+	Aggregators   []v1alpha1.Aggregator `json:"aggregators,omitempty"`
+	Window        *v1.Duration          `json:"window,omitempty"`
+	LabelSelector *v1.LabelSelector     `json:"labelSelector,omitempty"`
+}
+
+// MetricDeclarationApplyConfiguration constructs an declarative configuration of the MetricDeclaration type for use with
+// apply.
+func MetricDeclaration() *MetricDeclarationApplyConfiguration {
+	return &MetricDeclarationApplyConfiguration{}
+}
+
+// WithMetricName sets the MetricName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MetricName field is set to the value of the last call.
+func (b *MetricDeclarationApplyConfiguration) WithMetricName(value string) *MetricDeclarationApplyConfiguration {
+	b.MetricName = &value
+	return b
+}
+
+// WithAggregators adds the given value to the Aggregators field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Aggregators field.
+func (b *MetricDeclarationApplyConfiguration) WithAggregators(values ...v1alpha1.Aggregator) *MetricDeclarationApplyConfiguration {
+	for i := range values {
+		b.Aggregators = append(b.Aggregators, values[i])
+	}
+	return b
+}
+
+// WithWindow sets the Window field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Window field is set to the value of the last call.
+func (b *MetricDeclarationApplyConfiguration) WithWindow(value v1.Duration) *MetricDeclarationApplyConfiguration {
+	b.Window = &value
+	return b
+}
+
+// WithLabelSelector sets the LabelSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LabelSelector field is set to the value of the last call.
+func (b *MetricDeclarationApplyConfiguration) WithLabelSelector(value v1.LabelSelector) *MetricDeclarationApplyConfiguration {
+	b.LabelSelector = &value
+	return b
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// MetricSeriesApplyConfiguration represents an declarative configuration of the MetricSeries type for use
+// with apply.
+type MetricSeriesApplyConfiguration struct {
+	TimestampDeltas []int64 `json:"timestampDeltas,omitempty"`
+	ValueDeltas     []int64 `json:"valueDeltas,omitempty"`
+}
+
+// MetricSeriesApplyConfiguration constructs an declarative configuration of the MetricSeries type for use with
+// apply.
+func MetricSeries() *MetricSeriesApplyConfiguration {
+	return &MetricSeriesApplyConfiguration{}
+}
+
+// WithTimestampDeltas adds the given value to the TimestampDeltas field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the TimestampDeltas field.
+func (b *MetricSeriesApplyConfiguration) WithTimestampDeltas(values ...int64) *MetricSeriesApplyConfiguration {
+	for i := range values {
+		b.TimestampDeltas = append(b.TimestampDeltas, values[i])
+	}
+	return b
+}
+
+// WithValueDeltas adds the given value to the ValueDeltas field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ValueDeltas field.
+func (b *MetricSeriesApplyConfiguration) WithValueDeltas(values ...int64) *MetricSeriesApplyConfiguration {
+	for i := range values {
+		b.ValueDeltas = append(b.ValueDeltas, values[i])
+	}
+	return b
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/node/v1alpha1"
+	resource "k8s.io/apimachinery/pkg/api/resource"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MetricValueApplyConfiguration represents an declarative configuration of the MetricValue type for use
+// with apply.
+type MetricValueApplyConfiguration struct {
+	MetricName   *string                         `json:"metricName,omitempty"`
+	MetricLabels map[string]string               `json:"metricLabels,omitempty"`
+	Timestamp    *v1.Time                        `json:"timestamp,omitempty"`
+	Aggregator   *v1alpha1.Aggregator            `json:"aggregator,omitempty"`
+	Window       *v1.Duration                    `json:"window,omitempty"`
+	Value        *resource.Quantity              `json:"value,omitempty"`
+	Series       *MetricSeriesApplyConfiguration `json:"series,omitempty"`
+}
+
+// MetricValueApplyConfiguration constructs an declarative configuration of the MetricValue type for use with
+// apply.
+func MetricValue() *MetricValueApplyConfiguration {
+	return &MetricValueApplyConfiguration{}
+}
+
+// WithMetricName sets the MetricName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MetricName field is set to the value of the last call.
+func (b *MetricValueApplyConfiguration) WithMetricName(value string) *MetricValueApplyConfiguration {
+	b.MetricName = &value
+	return b
+}
+
+// WithMetricLabels puts the entries into the MetricLabels field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the MetricLabels field,
+// overwriting an existing map entries in MetricLabels field with the same key.
+func (b *MetricValueApplyConfiguration) WithMetricLabels(entries map[string]string) *MetricValueApplyConfiguration {
+	if b.MetricLabels == nil && len(entries) > 0 {
+		b.MetricLabels = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.MetricLabels[k] = v
+	}
+	return b
+}
+
+// WithTimestamp sets the Timestamp field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Timestamp field is set to the value of the last call.
+func (b *MetricValueApplyConfiguration) WithTimestamp(value v1.Time) *MetricValueApplyConfiguration {
+	b.Timestamp = &value
+	return b
+}
+
+// WithAggregator sets the Aggregator field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Aggregator field is set to the value of the last call.
+func (b *MetricValueApplyConfiguration) WithAggregator(value v1alpha1.Aggregator) *MetricValueApplyConfiguration {
+	b.Aggregator = &value
+	return b
+}
+
+// WithWindow sets the Window field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Window field is set to the value of the last call.
+func (b *MetricValueApplyConfiguration) WithWindow(value v1.Duration) *MetricValueApplyConfiguration {
+	b.Window = &value
+	return b
+}
+
+// WithValue sets the Value field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Value field is set to the value of the last call.
+func (b *MetricValueApplyConfiguration) WithValue(value resource.Quantity) *MetricValueApplyConfiguration {
+	b.Value = &value
+	return b
+}
+
+// WithSeries sets the Series field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Series field is set to the value of the last call.
+func (b *MetricValueApplyConfiguration) WithSeries(value *MetricSeriesApplyConfiguration) *MetricValueApplyConfiguration {
+	b.Series = value
+	return b
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// NodeMetricInfoApplyConfiguration represents an declarative configuration of the NodeMetricInfo type for use
+// with apply.
+type NodeMetricInfoApplyConfiguration struct {
+	ResourceUsageApplyConfiguration `json:",inline"`
+}
+
+// NodeMetricInfoApplyConfiguration constructs an declarative configuration of the NodeMetricInfo type for use with
+// apply.
+func NodeMetricInfo() *NodeMetricInfoApplyConfiguration {
+	return &NodeMetricInfoApplyConfiguration{}
+}
+
+// WithNUMAUsage adds the given value to the NUMAUsage field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the NUMAUsage field.
+func (b *NodeMetricInfoApplyConfiguration) WithNUMAUsage(values ...*NUMAMetricInfoApplyConfiguration) *NodeMetricInfoApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithNUMAUsage")
+		}
+		b.NUMAUsage = append(b.NUMAUsage, *values[i])
+	}
+	return b
+}
+
+// WithGenericUsage sets the GenericUsage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the GenericUsage field is set to the value of the last call.
+func (b *NodeMetricInfoApplyConfiguration) WithGenericUsage(value v1.ResourceList) *NodeMetricInfoApplyConfiguration {
+	b.GenericUsage = &value
+	return b
+}
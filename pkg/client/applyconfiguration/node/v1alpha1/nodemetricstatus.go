@@ -0,0 +1,66 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NodeMetricStatusApplyConfiguration represents an declarative configuration of the NodeMetricStatus type for use
+// with apply.
+type NodeMetricStatusApplyConfiguration struct {
+	UpdateTime  *v1.Time                            `json:"updateTime,omitempty"`
+	NodeMetric  *NodeMetricInfoApplyConfiguration   `json:"nodeMetric,omitempty"`
+	GroupMetric []GroupMetricInfoApplyConfiguration `json:"groupMetric,omitempty"`
+}
+
+// NodeMetricStatusApplyConfiguration constructs an declarative configuration of the NodeMetricStatus type for use with
+// apply.
+func NodeMetricStatus() *NodeMetricStatusApplyConfiguration {
+	return &NodeMetricStatusApplyConfiguration{}
+}
+
+// WithUpdateTime sets the UpdateTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UpdateTime field is set to the value of the last call.
+func (b *NodeMetricStatusApplyConfiguration) WithUpdateTime(value v1.Time) *NodeMetricStatusApplyConfiguration {
+	b.UpdateTime = &value
+	return b
+}
+
+// WithNodeMetric sets the NodeMetric field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NodeMetric field is set to the value of the last call.
+func (b *NodeMetricStatusApplyConfiguration) WithNodeMetric(value *NodeMetricInfoApplyConfiguration) *NodeMetricStatusApplyConfiguration {
+	b.NodeMetric = value
+	return b
+}
+
+// WithGroupMetric adds the given value to the GroupMetric field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the GroupMetric field.
+func (b *NodeMetricStatusApplyConfiguration) WithGroupMetric(values ...*GroupMetricInfoApplyConfiguration) *NodeMetricStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithGroupMetric")
+		}
+		b.GroupMetric = append(b.GroupMetric, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// NodeProfileDescriptorStatusApplyConfiguration represents an declarative configuration of the NodeProfileDescriptorStatus type for use
+// with apply.
+type NodeProfileDescriptorStatusApplyConfiguration struct {
+	NodeMetrics     []ScopedNodeMetricsApplyConfiguration `json:"nodeMetrics,omitempty"`
+	PodMetrics      []ScopedPodMetricsApplyConfiguration  `json:"podMetrics,omitempty"`
+	PodMetricShards []string                              `json:"podMetricShards,omitempty"`
+}
+
+// NodeProfileDescriptorStatusApplyConfiguration constructs an declarative configuration of the NodeProfileDescriptorStatus type for use with
+// apply.
+func NodeProfileDescriptorStatus() *NodeProfileDescriptorStatusApplyConfiguration {
+	return &NodeProfileDescriptorStatusApplyConfiguration{}
+}
+
+// WithNodeMetrics adds the given value to the NodeMetrics field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the NodeMetrics field.
+func (b *NodeProfileDescriptorStatusApplyConfiguration) WithNodeMetrics(values ...*ScopedNodeMetricsApplyConfiguration) *NodeProfileDescriptorStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithNodeMetrics")
+		}
+		b.NodeMetrics = append(b.NodeMetrics, *values[i])
+	}
+	return b
+}
+
+// WithPodMetrics adds the given value to the PodMetrics field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the PodMetrics field.
+func (b *NodeProfileDescriptorStatusApplyConfiguration) WithPodMetrics(values ...*ScopedPodMetricsApplyConfiguration) *NodeProfileDescriptorStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithPodMetrics")
+		}
+		b.PodMetrics = append(b.PodMetrics, *values[i])
+	}
+	return b
+}
+
+// WithPodMetricShards adds the given value to the PodMetricShards field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the PodMetricShards field.
+func (b *NodeProfileDescriptorStatusApplyConfiguration) WithPodMetricShards(values ...string) *NodeProfileDescriptorStatusApplyConfiguration {
+	for i := range values {
+		b.PodMetricShards = append(b.PodMetricShards, values[i])
+	}
+	return b
+}
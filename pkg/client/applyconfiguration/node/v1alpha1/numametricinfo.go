@@ -0,0 +1,52 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// NUMAMetricInfoApplyConfiguration represents an declarative configuration of the NUMAMetricInfo type for use
+// with apply.
+type NUMAMetricInfoApplyConfiguration struct {
+	NUMAId *int             `json:"numaId,omitempty"`
+	Usage  *v1.ResourceList `json:"usage,omitempty"`
+}
+
+// NUMAMetricInfoApplyConfiguration constructs an declarative configuration of the NUMAMetricInfo type for use with
+// apply.
+func NUMAMetricInfo() *NUMAMetricInfoApplyConfiguration {
+	return &NUMAMetricInfoApplyConfiguration{}
+}
+
+// WithNUMAId sets the NUMAId field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NUMAId field is set to the value of the last call.
+func (b *NUMAMetricInfoApplyConfiguration) WithNUMAId(value int) *NUMAMetricInfoApplyConfiguration {
+	b.NUMAId = &value
+	return b
+}
+
+// WithUsage sets the Usage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Usage field is set to the value of the last call.
+func (b *NUMAMetricInfoApplyConfiguration) WithUsage(value v1.ResourceList) *NUMAMetricInfoApplyConfiguration {
+	b.Usage = &value
+	return b
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// PodMetricApplyConfiguration represents an declarative configuration of the PodMetric type for use
+// with apply.
+type PodMetricApplyConfiguration struct {
+	Namespace *string                         `json:"namespace,omitempty"`
+	Name      *string                         `json:"name,omitempty"`
+	Metrics   []MetricValueApplyConfiguration `json:"metrics,omitempty"`
+}
+
+// PodMetricApplyConfiguration constructs an declarative configuration of the PodMetric type for use with
+// apply.
+func PodMetric() *PodMetricApplyConfiguration {
+	return &PodMetricApplyConfiguration{}
+}
+
+// WithNamespace sets the Namespace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Namespace field is set to the value of the last call.
+func (b *PodMetricApplyConfiguration) WithNamespace(value string) *PodMetricApplyConfiguration {
+	b.Namespace = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *PodMetricApplyConfiguration) WithName(value string) *PodMetricApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithMetrics adds the given value to the Metrics field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Metrics field.
+func (b *PodMetricApplyConfiguration) WithMetrics(values ...*MetricValueApplyConfiguration) *PodMetricApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithMetrics")
+		}
+		b.Metrics = append(b.Metrics, *values[i])
+	}
+	return b
+}
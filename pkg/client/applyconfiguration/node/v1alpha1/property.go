@@ -0,0 +1,63 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	resource "k8s.io/apimachinery/pkg/api/resource"
+)
+
+// PropertyApplyConfiguration represents an declarative configuration of the Property type for use
+// with apply.
+type PropertyApplyConfiguration struct {
+	PropertyName     *string            `json:"propertyName,omitempty"`
+	PropertyValues   []string           `json:"propertyValues,omitempty"`
+	PropertyQuantity *resource.Quantity `json:"propertyQuantity,omitempty"`
+}
+
+// PropertyApplyConfiguration constructs an declarative configuration of the Property type for use with
+// apply.
+func Property() *PropertyApplyConfiguration {
+	return &PropertyApplyConfiguration{}
+}
+
+// WithPropertyName sets the PropertyName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PropertyName field is set to the value of the last call.
+func (b *PropertyApplyConfiguration) WithPropertyName(value string) *PropertyApplyConfiguration {
+	b.PropertyName = &value
+	return b
+}
+
+// WithPropertyValues adds the given value to the PropertyValues field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the PropertyValues field.
+func (b *PropertyApplyConfiguration) WithPropertyValues(values ...string) *PropertyApplyConfiguration {
+	for i := range values {
+		b.PropertyValues = append(b.PropertyValues, values[i])
+	}
+	return b
+}
+
+// WithPropertyQuantity sets the PropertyQuantity field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PropertyQuantity field is set to the value of the last call.
+func (b *PropertyApplyConfiguration) WithPropertyQuantity(value resource.Quantity) *PropertyApplyConfiguration {
+	b.PropertyQuantity = &value
+	return b
+}
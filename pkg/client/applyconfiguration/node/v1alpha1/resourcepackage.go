@@ -0,0 +1,66 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// ResourcePackageApplyConfiguration represents an declarative configuration of the ResourcePackage type for use
+// with apply.
+type ResourcePackageApplyConfiguration struct {
+	PackageName *string                       `json:"packageName,omitempty"`
+	Allocatable *v1.ResourceList              `json:"allocatable,omitempty"`
+	Attributes  []AttributeApplyConfiguration `json:"attributes,omitempty"`
+}
+
+// ResourcePackageApplyConfiguration constructs an declarative configuration of the ResourcePackage type for use with
+// apply.
+func ResourcePackage() *ResourcePackageApplyConfiguration {
+	return &ResourcePackageApplyConfiguration{}
+}
+
+// WithPackageName sets the PackageName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PackageName field is set to the value of the last call.
+func (b *ResourcePackageApplyConfiguration) WithPackageName(value string) *ResourcePackageApplyConfiguration {
+	b.PackageName = &value
+	return b
+}
+
+// WithAllocatable sets the Allocatable field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Allocatable field is set to the value of the last call.
+func (b *ResourcePackageApplyConfiguration) WithAllocatable(value v1.ResourceList) *ResourcePackageApplyConfiguration {
+	b.Allocatable = &value
+	return b
+}
+
+// WithAttributes adds the given value to the Attributes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Attributes field.
+func (b *ResourcePackageApplyConfiguration) WithAttributes(values ...*AttributeApplyConfiguration) *ResourcePackageApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAttributes")
+		}
+		b.Attributes = append(b.Attributes, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// ResourcePoolApplyConfiguration represents an declarative configuration of the ResourcePool type for use
+// with apply.
+type ResourcePoolApplyConfiguration struct {
+	PoolName       *string                       `json:"poolName,omitempty"`
+	MinAllocatable *v1.ResourceList              `json:"minAllocatable,omitempty"`
+	MaxAllocatable *v1.ResourceList              `json:"maxAllocatable,omitempty"`
+	Attributes     []AttributeApplyConfiguration `json:"attributes,omitempty"`
+}
+
+// ResourcePoolApplyConfiguration constructs an declarative configuration of the ResourcePool type for use with
+// apply.
+func ResourcePool() *ResourcePoolApplyConfiguration {
+	return &ResourcePoolApplyConfiguration{}
+}
+
+// WithPoolName sets the PoolName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PoolName field is set to the value of the last call.
+func (b *ResourcePoolApplyConfiguration) WithPoolName(value string) *ResourcePoolApplyConfiguration {
+	b.PoolName = &value
+	return b
+}
+
+// WithMinAllocatable sets the MinAllocatable field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MinAllocatable field is set to the value of the last call.
+func (b *ResourcePoolApplyConfiguration) WithMinAllocatable(value v1.ResourceList) *ResourcePoolApplyConfiguration {
+	b.MinAllocatable = &value
+	return b
+}
+
+// WithMaxAllocatable sets the MaxAllocatable field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxAllocatable field is set to the value of the last call.
+func (b *ResourcePoolApplyConfiguration) WithMaxAllocatable(value v1.ResourceList) *ResourcePoolApplyConfiguration {
+	b.MaxAllocatable = &value
+	return b
+}
+
+// WithAttributes adds the given value to the Attributes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Attributes field.
+func (b *ResourcePoolApplyConfiguration) WithAttributes(values ...*AttributeApplyConfiguration) *ResourcePoolApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAttributes")
+		}
+		b.Attributes = append(b.Attributes, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// ResourcesApplyConfiguration represents an declarative configuration of the Resources type for use
+// with apply.
+type ResourcesApplyConfiguration struct {
+	Allocatable      *v1.ResourceList                    `json:"allocatable,omitempty"`
+	Capacity         *v1.ResourceList                    `json:"capacity,omitempty"`
+	ResourcePackages []ResourcePackageApplyConfiguration `json:"resourcePackages,omitempty"`
+	ResourcePools    []ResourcePoolApplyConfiguration    `json:"resourcePools,omitempty"`
+}
+
+// ResourcesApplyConfiguration constructs an declarative configuration of the Resources type for use with
+// apply.
+func Resources() *ResourcesApplyConfiguration {
+	return &ResourcesApplyConfiguration{}
+}
+
+// WithAllocatable sets the Allocatable field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Allocatable field is set to the value of the last call.
+func (b *ResourcesApplyConfiguration) WithAllocatable(value v1.ResourceList) *ResourcesApplyConfiguration {
+	b.Allocatable = &value
+	return b
+}
+
+// WithCapacity sets the Capacity field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Capacity field is set to the value of the last call.
+func (b *ResourcesApplyConfiguration) WithCapacity(value v1.ResourceList) *ResourcesApplyConfiguration {
+	b.Capacity = &value
+	return b
+}
+
+// WithResourcePackages adds the given value to the ResourcePackages field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ResourcePackages field.
+func (b *ResourcesApplyConfiguration) WithResourcePackages(values ...*ResourcePackageApplyConfiguration) *ResourcesApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithResourcePackages")
+		}
+		b.ResourcePackages = append(b.ResourcePackages, *values[i])
+	}
+	return b
+}
+
+// WithResourcePools adds the given value to the ResourcePools field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ResourcePools field.
+func (b *ResourcesApplyConfiguration) WithResourcePools(values ...*ResourcePoolApplyConfiguration) *ResourcesApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithResourcePools")
+		}
+		b.ResourcePools = append(b.ResourcePools, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// ScopedNodeMetricsApplyConfiguration represents an declarative configuration of the ScopedNodeMetrics type for use
+// with apply.
+type ScopedNodeMetricsApplyConfiguration struct {
+	Scope   *string                         `json:"scope,omitempty"`
+	Metrics []MetricValueApplyConfiguration `json:"metrics,omitempty"`
+}
+
+// ScopedNodeMetricsApplyConfiguration constructs an declarative configuration of the ScopedNodeMetrics type for use with
+// apply.
+func ScopedNodeMetrics() *ScopedNodeMetricsApplyConfiguration {
+	return &ScopedNodeMetricsApplyConfiguration{}
+}
+
+// WithScope sets the Scope field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Scope field is set to the value of the last call.
+func (b *ScopedNodeMetricsApplyConfiguration) WithScope(value string) *ScopedNodeMetricsApplyConfiguration {
+	b.Scope = &value
+	return b
+}
+
+// WithMetrics adds the given value to the Metrics field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Metrics field.
+func (b *ScopedNodeMetricsApplyConfiguration) WithMetrics(values ...*MetricValueApplyConfiguration) *ScopedNodeMetricsApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithMetrics")
+		}
+		b.Metrics = append(b.Metrics, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// ScopedPodMetricsApplyConfiguration represents an declarative configuration of the ScopedPodMetrics type for use
+// with apply.
+type ScopedPodMetricsApplyConfiguration struct {
+	Scope      *string                       `json:"scope,omitempty"`
+	PodMetrics []PodMetricApplyConfiguration `json:"podMetrics,omitempty"`
+}
+
+// ScopedPodMetricsApplyConfiguration constructs an declarative configuration of the ScopedPodMetrics type for use with
+// apply.
+func ScopedPodMetrics() *ScopedPodMetricsApplyConfiguration {
+	return &ScopedPodMetricsApplyConfiguration{}
+}
+
+// WithScope sets the Scope field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Scope field is set to the value of the last call.
+func (b *ScopedPodMetricsApplyConfiguration) WithScope(value string) *ScopedPodMetricsApplyConfiguration {
+	b.Scope = &value
+	return b
+}
+
+// WithPodMetrics adds the given value to the PodMetrics field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the PodMetrics field.
+func (b *ScopedPodMetricsApplyConfiguration) WithPodMetrics(values ...*PodMetricApplyConfiguration) *ScopedPodMetricsApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithPodMetrics")
+		}
+		b.PodMetrics = append(b.PodMetrics, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/node/v1alpha1"
+)
+
+// TopologyZoneApplyConfiguration represents an declarative configuration of the TopologyZone type for use
+// with apply.
+type TopologyZoneApplyConfiguration struct {
+	Type        *v1alpha1.TopologyType        `json:"type,omitempty"`
+	Name        *string                       `json:"name,omitempty"`
+	Resources   *ResourcesApplyConfiguration  `json:"resources,omitempty"`
+	Attributes  []AttributeApplyConfiguration `json:"attributes,omitempty"`
+	Allocations []*v1alpha1.Allocation        `json:"allocations,omitempty"`
+	Children    []*v1alpha1.TopologyZone      `json:"children,omitempty"`
+	Siblings    []SiblingApplyConfiguration   `json:"siblings,omitempty"`
+}
+
+// TopologyZoneApplyConfiguration constructs an declarative configuration of the TopologyZone type for use with
+// apply.
+func TopologyZone() *TopologyZoneApplyConfiguration {
+	return &TopologyZoneApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *TopologyZoneApplyConfiguration) WithType(value v1alpha1.TopologyType) *TopologyZoneApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *TopologyZoneApplyConfiguration) WithName(value string) *TopologyZoneApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithResources sets the Resources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resources field is set to the value of the last call.
+func (b *TopologyZoneApplyConfiguration) WithResources(value *ResourcesApplyConfiguration) *TopologyZoneApplyConfiguration {
+	b.Resources = value
+	return b
+}
+
+// WithAttributes adds the given value to the Attributes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Attributes field.
+func (b *TopologyZoneApplyConfiguration) WithAttributes(values ...*AttributeApplyConfiguration) *TopologyZoneApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAttributes")
+		}
+		b.Attributes = append(b.Attributes, *values[i])
+	}
+	return b
+}
+
+// WithAllocations adds the given value to the Allocations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Allocations field.
+func (b *TopologyZoneApplyConfiguration) WithAllocations(values ...**v1alpha1.Allocation) *TopologyZoneApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAllocations")
+		}
+		b.Allocations = append(b.Allocations, *values[i])
+	}
+	return b
+}
+
+// WithChildren adds the given value to the Children field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Children field.
+func (b *TopologyZoneApplyConfiguration) WithChildren(values ...**v1alpha1.TopologyZone) *TopologyZoneApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithChildren")
+		}
+		b.Children = append(b.Children, *values[i])
+	}
+	return b
+}
+
+// WithSiblings adds the given value to the Siblings field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Siblings field.
+func (b *TopologyZoneApplyConfiguration) WithSiblings(values ...*SiblingApplyConfiguration) *TopologyZoneApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithSiblings")
+		}
+		b.Siblings = append(b.Siblings, *values[i])
+	}
+	return b
+}
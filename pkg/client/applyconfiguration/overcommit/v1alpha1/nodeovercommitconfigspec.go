@@ -0,0 +1,58 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// NodeOvercommitConfigSpecApplyConfiguration represents an declarative configuration of the NodeOvercommitConfigSpec type for use
+// with apply.
+type NodeOvercommitConfigSpecApplyConfiguration struct {
+	NodeOvercommitSelectorVal *string                    `json:"nodeOvercommitSelectorVal,omitempty"`
+	ResourceOvercommitRatio   map[v1.ResourceName]string `json:"resourceOvercommitRatio,omitempty"`
+}
+
+// NodeOvercommitConfigSpecApplyConfiguration constructs an declarative configuration of the NodeOvercommitConfigSpec type for use with
+// apply.
+func NodeOvercommitConfigSpec() *NodeOvercommitConfigSpecApplyConfiguration {
+	return &NodeOvercommitConfigSpecApplyConfiguration{}
+}
+
+// WithNodeOvercommitSelectorVal sets the NodeOvercommitSelectorVal field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NodeOvercommitSelectorVal field is set to the value of the last call.
+func (b *NodeOvercommitConfigSpecApplyConfiguration) WithNodeOvercommitSelectorVal(value string) *NodeOvercommitConfigSpecApplyConfiguration {
+	b.NodeOvercommitSelectorVal = &value
+	return b
+}
+
+// WithResourceOvercommitRatio puts the entries into the ResourceOvercommitRatio field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the ResourceOvercommitRatio field,
+// overwriting an existing map entries in ResourceOvercommitRatio field with the same key.
+func (b *NodeOvercommitConfigSpecApplyConfiguration) WithResourceOvercommitRatio(entries map[v1.ResourceName]string) *NodeOvercommitConfigSpecApplyConfiguration {
+	if b.ResourceOvercommitRatio == nil && len(entries) > 0 {
+		b.ResourceOvercommitRatio = make(map[v1.ResourceName]string, len(entries))
+	}
+	for k, v := range entries {
+		b.ResourceOvercommitRatio[k] = v
+	}
+	return b
+}
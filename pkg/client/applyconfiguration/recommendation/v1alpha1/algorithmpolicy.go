@@ -0,0 +1,62 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/recommendation/v1alpha1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// AlgorithmPolicyApplyConfiguration represents an declarative configuration of the AlgorithmPolicy type for use
+// with apply.
+type AlgorithmPolicyApplyConfiguration struct {
+	Recommender *string               `json:"recommender,omitempty"`
+	Algorithm   *v1alpha1.Algorithm   `json:"algorithm,omitempty"`
+	Extensions  *runtime.RawExtension `json:"extensions,omitempty"`
+}
+
+// AlgorithmPolicyApplyConfiguration constructs an declarative configuration of the AlgorithmPolicy type for use with
+// apply.
+func AlgorithmPolicy() *AlgorithmPolicyApplyConfiguration {
+	return &AlgorithmPolicyApplyConfiguration{}
+}
+
+// WithRecommender sets the Recommender field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Recommender field is set to the value of the last call.
+func (b *AlgorithmPolicyApplyConfiguration) WithRecommender(value string) *AlgorithmPolicyApplyConfiguration {
+	b.Recommender = &value
+	return b
+}
+
+// WithAlgorithm sets the Algorithm field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Algorithm field is set to the value of the last call.
+func (b *AlgorithmPolicyApplyConfiguration) WithAlgorithm(value v1alpha1.Algorithm) *AlgorithmPolicyApplyConfiguration {
+	b.Algorithm = &value
+	return b
+}
+
+// WithExtensions sets the Extensions field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Extensions field is set to the value of the last call.
+func (b *AlgorithmPolicyApplyConfiguration) WithExtensions(value runtime.RawExtension) *AlgorithmPolicyApplyConfiguration {
+	b.Extensions = &value
+	return b
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/recommendation/v1alpha1"
+	v1 "k8s.io/api/core/v1"
+	resource "k8s.io/apimachinery/pkg/api/resource"
+)
+
+// ContainerControlledResourcesPolicyApplyConfiguration represents an declarative configuration of the ContainerControlledResourcesPolicy type for use
+// with apply.
+type ContainerControlledResourcesPolicyApplyConfiguration struct {
+	ResourceName     *v1.ResourceName                    `json:"resourceName,omitempty"`
+	MinAllowed       *resource.Quantity                  `json:"minAllowed,omitempty"`
+	MaxAllowed       *resource.Quantity                  `json:"maxAllowed,omitempty"`
+	BufferPercent    *int32                              `json:"bufferPercent,omitempty"`
+	ControlledValues *v1alpha1.ContainerControlledValues `json:"controlledValues,omitempty"`
+}
+
+// ContainerControlledResourcesPolicyApplyConfiguration constructs an declarative configuration of the ContainerControlledResourcesPolicy type for use with
+// apply.
+func ContainerControlledResourcesPolicy() *ContainerControlledResourcesPolicyApplyConfiguration {
+	return &ContainerControlledResourcesPolicyApplyConfiguration{}
+}
+
+// WithResourceName sets the ResourceName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResourceName field is set to the value of the last call.
+func (b *ContainerControlledResourcesPolicyApplyConfiguration) WithResourceName(value v1.ResourceName) *ContainerControlledResourcesPolicyApplyConfiguration {
+	b.ResourceName = &value
+	return b
+}
+
+// WithMinAllowed sets the MinAllowed field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MinAllowed field is set to the value of the last call.
+func (b *ContainerControlledResourcesPolicyApplyConfiguration) WithMinAllowed(value resource.Quantity) *ContainerControlledResourcesPolicyApplyConfiguration {
+	b.MinAllowed = &value
+	return b
+}
+
+// WithMaxAllowed sets the MaxAllowed field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxAllowed field is set to the value of the last call.
+func (b *ContainerControlledResourcesPolicyApplyConfiguration) WithMaxAllowed(value resource.Quantity) *ContainerControlledResourcesPolicyApplyConfiguration {
+	b.MaxAllowed = &value
+	return b
+}
+
+// WithBufferPercent sets the BufferPercent field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BufferPercent field is set to the value of the last call.
+func (b *ContainerControlledResourcesPolicyApplyConfiguration) WithBufferPercent(value int32) *ContainerControlledResourcesPolicyApplyConfiguration {
+	b.BufferPercent = &value
+	return b
+}
+
+// WithControlledValues sets the ControlledValues field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ControlledValues field is set to the value of the last call.
+func (b *ContainerControlledResourcesPolicyApplyConfiguration) WithControlledValues(value v1alpha1.ContainerControlledValues) *ContainerControlledResourcesPolicyApplyConfiguration {
+	b.ControlledValues = &value
+	return b
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// ContainerResourcePolicyApplyConfiguration represents an declarative configuration of the ContainerResourcePolicy type for use
+// with apply.
+type ContainerResourcePolicyApplyConfiguration struct {
+	ContainerName               *string                                                `json:"containerName,omitempty"`
+	ControlledResourcesPolicies []ContainerControlledResourcesPolicyApplyConfiguration `json:"controlledResourcesPolicies,omitempty"`
+}
+
+// ContainerResourcePolicyApplyConfiguration constructs an declarative configuration of the ContainerResourcePolicy type for use with
+// apply.
+func ContainerResourcePolicy() *ContainerResourcePolicyApplyConfiguration {
+	return &ContainerResourcePolicyApplyConfiguration{}
+}
+
+// WithContainerName sets the ContainerName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ContainerName field is set to the value of the last call.
+func (b *ContainerResourcePolicyApplyConfiguration) WithContainerName(value string) *ContainerResourcePolicyApplyConfiguration {
+	b.ContainerName = &value
+	return b
+}
+
+// WithControlledResourcesPolicies adds the given value to the ControlledResourcesPolicies field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ControlledResourcesPolicies field.
+func (b *ContainerResourcePolicyApplyConfiguration) WithControlledResourcesPolicies(values ...*ContainerControlledResourcesPolicyApplyConfiguration) *ContainerResourcePolicyApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithControlledResourcesPolicies")
+		}
+		b.ControlledResourcesPolicies = append(b.ControlledResourcesPolicies, *values[i])
+	}
+	return b
+}
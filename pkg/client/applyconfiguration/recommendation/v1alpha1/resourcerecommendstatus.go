@@ -0,0 +1,75 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ResourceRecommendStatusApplyConfiguration represents an declarative configuration of the ResourceRecommendStatus type for use
+// with apply.
+type ResourceRecommendStatusApplyConfiguration struct {
+	LastRecommendationTime *v1.Time                                       `json:"lastRecommendationTime,omitempty"`
+	RecommendResources     *RecommendResourcesApplyConfiguration          `json:"recommendResources,omitempty"`
+	Conditions             []ResourceRecommendConditionApplyConfiguration `json:"conditions,omitempty"`
+	ObservedGeneration     *int64                                         `json:"observedGeneration,omitempty"`
+}
+
+// ResourceRecommendStatusApplyConfiguration constructs an declarative configuration of the ResourceRecommendStatus type for use with
+// apply.
+func ResourceRecommendStatus() *ResourceRecommendStatusApplyConfiguration {
+	return &ResourceRecommendStatusApplyConfiguration{}
+}
+
+// WithLastRecommendationTime sets the LastRecommendationTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastRecommendationTime field is set to the value of the last call.
+func (b *ResourceRecommendStatusApplyConfiguration) WithLastRecommendationTime(value v1.Time) *ResourceRecommendStatusApplyConfiguration {
+	b.LastRecommendationTime = &value
+	return b
+}
+
+// WithRecommendResources sets the RecommendResources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RecommendResources field is set to the value of the last call.
+func (b *ResourceRecommendStatusApplyConfiguration) WithRecommendResources(value *RecommendResourcesApplyConfiguration) *ResourceRecommendStatusApplyConfiguration {
+	b.RecommendResources = value
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *ResourceRecommendStatusApplyConfiguration) WithConditions(values ...*ResourceRecommendConditionApplyConfiguration) *ResourceRecommendStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
+
+// WithObservedGeneration sets the ObservedGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObservedGeneration field is set to the value of the last call.
+func (b *ResourceRecommendStatusApplyConfiguration) WithObservedGeneration(value int64) *ResourceRecommendStatusApplyConfiguration {
+	b.ObservedGeneration = &value
+	return b
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// EvictStrategyApplyConfiguration represents an declarative configuration of the EvictStrategy type for use
+// with apply.
+type EvictStrategyApplyConfiguration struct {
+	Type      *string                      `json:"type,omitempty"`
+	Watermark *WatermarkApplyConfiguration `json:"watermark,omitempty"`
+}
+
+// EvictStrategyApplyConfiguration constructs an declarative configuration of the EvictStrategy type for use with
+// apply.
+func EvictStrategy() *EvictStrategyApplyConfiguration {
+	return &EvictStrategyApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *EvictStrategyApplyConfiguration) WithType(value string) *EvictStrategyApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithWatermark sets the Watermark field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Watermark field is set to the value of the last call.
+func (b *EvictStrategyApplyConfiguration) WithWatermark(value *WatermarkApplyConfiguration) *EvictStrategyApplyConfiguration {
+	b.Watermark = value
+	return b
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// NodeConfigsApplyConfiguration represents an declarative configuration of the NodeConfigs type for use
+// with apply.
+type NodeConfigsApplyConfiguration struct {
+	NodeSelector map[string]string                 `json:"nodeSelector,omitempty"`
+	OnlineLabel  *LabelOptionApplyConfiguration    `json:"onlineLabel,omitempty"`
+	OfflineLabel *LabelOptionApplyConfiguration    `json:"offlineLabel,omitempty"`
+	TideLabel    *LabelOptionApplyConfiguration    `json:"tideLabel,omitempty"`
+	Reserve      *ReserveOptionsApplyConfiguration `json:"reserve,omitempty"`
+}
+
+// NodeConfigsApplyConfiguration constructs an declarative configuration of the NodeConfigs type for use with
+// apply.
+func NodeConfigs() *NodeConfigsApplyConfiguration {
+	return &NodeConfigsApplyConfiguration{}
+}
+
+// WithNodeSelector puts the entries into the NodeSelector field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the NodeSelector field,
+// overwriting an existing map entries in NodeSelector field with the same key.
+func (b *NodeConfigsApplyConfiguration) WithNodeSelector(entries map[string]string) *NodeConfigsApplyConfiguration {
+	if b.NodeSelector == nil && len(entries) > 0 {
+		b.NodeSelector = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.NodeSelector[k] = v
+	}
+	return b
+}
+
+// WithOnlineLabel sets the OnlineLabel field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OnlineLabel field is set to the value of the last call.
+func (b *NodeConfigsApplyConfiguration) WithOnlineLabel(value *LabelOptionApplyConfiguration) *NodeConfigsApplyConfiguration {
+	b.OnlineLabel = value
+	return b
+}
+
+// WithOfflineLabel sets the OfflineLabel field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OfflineLabel field is set to the value of the last call.
+func (b *NodeConfigsApplyConfiguration) WithOfflineLabel(value *LabelOptionApplyConfiguration) *NodeConfigsApplyConfiguration {
+	b.OfflineLabel = value
+	return b
+}
+
+// WithTideLabel sets the TideLabel field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TideLabel field is set to the value of the last call.
+func (b *NodeConfigsApplyConfiguration) WithTideLabel(value *LabelOptionApplyConfiguration) *NodeConfigsApplyConfiguration {
+	b.TideLabel = value
+	return b
+}
+
+// WithReserve sets the Reserve field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Reserve field is set to the value of the last call.
+func (b *NodeConfigsApplyConfiguration) WithReserve(value *ReserveOptionsApplyConfiguration) *NodeConfigsApplyConfiguration {
+	b.Reserve = value
+	return b
+}
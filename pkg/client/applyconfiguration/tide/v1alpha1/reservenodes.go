@@ -0,0 +1,52 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// ReserveNodesApplyConfiguration represents an declarative configuration of the ReserveNodes type for use
+// with apply.
+type ReserveNodesApplyConfiguration struct {
+	OnlineNodes  []string `json:"onlineNodes,omitempty"`
+	OfflineNodes []string `json:"offlineNodes,omitempty"`
+}
+
+// ReserveNodesApplyConfiguration constructs an declarative configuration of the ReserveNodes type for use with
+// apply.
+func ReserveNodes() *ReserveNodesApplyConfiguration {
+	return &ReserveNodesApplyConfiguration{}
+}
+
+// WithOnlineNodes adds the given value to the OnlineNodes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the OnlineNodes field.
+func (b *ReserveNodesApplyConfiguration) WithOnlineNodes(values ...string) *ReserveNodesApplyConfiguration {
+	for i := range values {
+		b.OnlineNodes = append(b.OnlineNodes, values[i])
+	}
+	return b
+}
+
+// WithOfflineNodes adds the given value to the OfflineNodes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the OfflineNodes field.
+func (b *ReserveNodesApplyConfiguration) WithOfflineNodes(values ...string) *ReserveNodesApplyConfiguration {
+	for i := range values {
+		b.OfflineNodes = append(b.OfflineNodes, values[i])
+	}
+	return b
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// ReserveOptionsApplyConfiguration represents an declarative configuration of the ReserveOptions type for use
+// with apply.
+type ReserveOptionsApplyConfiguration struct {
+	Online  *intstr.IntOrString `json:"online,omitempty"`
+	Offline *intstr.IntOrString `json:"offline,omitempty"`
+}
+
+// ReserveOptionsApplyConfiguration constructs an declarative configuration of the ReserveOptions type for use with
+// apply.
+func ReserveOptions() *ReserveOptionsApplyConfiguration {
+	return &ReserveOptionsApplyConfiguration{}
+}
+
+// WithOnline sets the Online field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Online field is set to the value of the last call.
+func (b *ReserveOptionsApplyConfiguration) WithOnline(value intstr.IntOrString) *ReserveOptionsApplyConfiguration {
+	b.Online = &value
+	return b
+}
+
+// WithOffline sets the Offline field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Offline field is set to the value of the last call.
+func (b *ReserveOptionsApplyConfiguration) WithOffline(value intstr.IntOrString) *ReserveOptionsApplyConfiguration {
+	b.Offline = &value
+	return b
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// WatermarkApplyConfiguration represents an declarative configuration of the Watermark type for use
+// with apply.
+type WatermarkApplyConfiguration struct {
+	EvictOnlinePodTaint  *TaintOptionApplyConfiguration `json:"evictOnlinePodTaint,omitempty"`
+	EvictOfflinePodTaint *TaintOptionApplyConfiguration `json:"evictOfflinePodTaint,omitempty"`
+}
+
+// WatermarkApplyConfiguration constructs an declarative configuration of the Watermark type for use with
+// apply.
+func Watermark() *WatermarkApplyConfiguration {
+	return &WatermarkApplyConfiguration{}
+}
+
+// WithEvictOnlinePodTaint sets the EvictOnlinePodTaint field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EvictOnlinePodTaint field is set to the value of the last call.
+func (b *WatermarkApplyConfiguration) WithEvictOnlinePodTaint(value *TaintOptionApplyConfiguration) *WatermarkApplyConfiguration {
+	b.EvictOnlinePodTaint = value
+	return b
+}
+
+// WithEvictOfflinePodTaint sets the EvictOfflinePodTaint field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EvictOfflinePodTaint field is set to the value of the last call.
+func (b *WatermarkApplyConfiguration) WithEvictOfflinePodTaint(value *TaintOptionApplyConfiguration) *WatermarkApplyConfiguration {
+	b.EvictOfflinePodTaint = value
+	return b
+}
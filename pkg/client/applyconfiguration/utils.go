@@ -0,0 +1,309 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package applyconfiguration
+
+import (
+	v1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/autoscaling/v1alpha1"
+	v1alpha2 "github.com/kubewharf/katalyst-api/pkg/apis/autoscaling/v1alpha2"
+	configv1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/config/v1alpha1"
+	nodev1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/node/v1alpha1"
+	overcommitv1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/overcommit/v1alpha1"
+	recommendationv1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/recommendation/v1alpha1"
+	tidev1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/tide/v1alpha1"
+	workloadv1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/workload/v1alpha1"
+	autoscalingv1alpha1 "github.com/kubewharf/katalyst-api/pkg/client/applyconfiguration/autoscaling/v1alpha1"
+	autoscalingv1alpha2 "github.com/kubewharf/katalyst-api/pkg/client/applyconfiguration/autoscaling/v1alpha2"
+	applyconfigurationconfigv1alpha1 "github.com/kubewharf/katalyst-api/pkg/client/applyconfiguration/config/v1alpha1"
+	applyconfigurationnodev1alpha1 "github.com/kubewharf/katalyst-api/pkg/client/applyconfiguration/node/v1alpha1"
+	applyconfigurationovercommitv1alpha1 "github.com/kubewharf/katalyst-api/pkg/client/applyconfiguration/overcommit/v1alpha1"
+	applyconfigurationrecommendationv1alpha1 "github.com/kubewharf/katalyst-api/pkg/client/applyconfiguration/recommendation/v1alpha1"
+	applyconfigurationtidev1alpha1 "github.com/kubewharf/katalyst-api/pkg/client/applyconfiguration/tide/v1alpha1"
+	applyconfigurationworkloadv1alpha1 "github.com/kubewharf/katalyst-api/pkg/client/applyconfiguration/workload/v1alpha1"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ForKind returns an apply configuration type for the given GroupVersionKind, or nil if no
+// apply configuration type exists for the given GroupVersionKind.
+func ForKind(kind schema.GroupVersionKind) interface{} {
+	switch kind {
+	// Group=autoscaling.katalyst.kubewharf.io, Version=v1alpha1
+	case v1alpha1.SchemeGroupVersion.WithKind("AlgorithmPolicy"):
+		return &autoscalingv1alpha1.AlgorithmPolicyApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ContainerResourceList"):
+		return &autoscalingv1alpha1.ContainerResourceListApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ContainerResourcePolicy"):
+		return &autoscalingv1alpha1.ContainerResourcePolicyApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ContainerResources"):
+		return &autoscalingv1alpha1.ContainerResourcesApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("CrossVersionObjectReference"):
+		return &autoscalingv1alpha1.CrossVersionObjectReferenceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("KatalystVerticalPodAutoscaler"):
+		return &autoscalingv1alpha1.KatalystVerticalPodAutoscalerApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("KatalystVerticalPodAutoscalerSpec"):
+		return &autoscalingv1alpha1.KatalystVerticalPodAutoscalerSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("KatalystVerticalPodAutoscalerStatus"):
+		return &autoscalingv1alpha1.KatalystVerticalPodAutoscalerStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("PodResourcePolicy"):
+		return &autoscalingv1alpha1.PodResourcePolicyApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("PodResources"):
+		return &autoscalingv1alpha1.PodResourcesApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("PodUpdatePolicy"):
+		return &autoscalingv1alpha1.PodUpdatePolicyApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RecommendedContainerResources"):
+		return &autoscalingv1alpha1.RecommendedContainerResourcesApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RecommendedPodResources"):
+		return &autoscalingv1alpha1.RecommendedPodResourcesApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RecommendedRequestResources"):
+		return &autoscalingv1alpha1.RecommendedRequestResourcesApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VerticalPodAutoscalerCondition"):
+		return &autoscalingv1alpha1.VerticalPodAutoscalerConditionApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VerticalPodAutoscalerRecommendation"):
+		return &autoscalingv1alpha1.VerticalPodAutoscalerRecommendationApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VerticalPodAutoscalerRecommendationCondition"):
+		return &autoscalingv1alpha1.VerticalPodAutoscalerRecommendationConditionApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VerticalPodAutoscalerRecommendationSpec"):
+		return &autoscalingv1alpha1.VerticalPodAutoscalerRecommendationSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VerticalPodAutoscalerRecommendationStatus"):
+		return &autoscalingv1alpha1.VerticalPodAutoscalerRecommendationStatusApplyConfiguration{}
+
+		// Group=autoscaling.katalyst.kubewharf.io, Version=v1alpha2
+	case v1alpha2.SchemeGroupVersion.WithKind("AutoscalerSpec"):
+		return &autoscalingv1alpha2.AutoscalerSpecApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("Bound"):
+		return &autoscalingv1alpha2.BoundApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("CustomMetricSpec"):
+		return &autoscalingv1alpha2.CustomMetricSpecApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("IntelligentHorizontalPodAutoscaler"):
+		return &autoscalingv1alpha2.IntelligentHorizontalPodAutoscalerApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("IntelligentHorizontalPodAutoscalerSpec"):
+		return &autoscalingv1alpha2.IntelligentHorizontalPodAutoscalerSpecApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("IntelligentHorizontalPodAutoscalerStatus"):
+		return &autoscalingv1alpha2.IntelligentHorizontalPodAutoscalerStatusApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("KatalystVerticalPodAutoscaler"):
+		return &autoscalingv1alpha2.KatalystVerticalPodAutoscalerApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("KatalystVerticalPodAutoscalerSpec"):
+		return &autoscalingv1alpha2.KatalystVerticalPodAutoscalerSpecApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("KatalystVerticalPodAutoscalerStatus"):
+		return &autoscalingv1alpha2.KatalystVerticalPodAutoscalerStatusApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("MetricSpec"):
+		return &autoscalingv1alpha2.MetricSpecApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("PodUpdatePolicy"):
+		return &autoscalingv1alpha2.PodUpdatePolicyApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("TimeBound"):
+		return &autoscalingv1alpha2.TimeBoundApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("VerticalPodAutoscalerCondition"):
+		return &autoscalingv1alpha2.VerticalPodAutoscalerConditionApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("VirtualWorkload"):
+		return &autoscalingv1alpha2.VirtualWorkloadApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("VirtualWorkloadSpec"):
+		return &autoscalingv1alpha2.VirtualWorkloadSpecApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("VirtualWorkloadStatus"):
+		return &autoscalingv1alpha2.VirtualWorkloadStatusApplyConfiguration{}
+
+		// Group=config.katalyst.kubewharf.io, Version=v1alpha1
+	case configv1alpha1.SchemeGroupVersion.WithKind("AlgorithmConfig"):
+		return &applyconfigurationconfigv1alpha1.AlgorithmConfigApplyConfiguration{}
+	case configv1alpha1.SchemeGroupVersion.WithKind("ConfigUpdateStrategy"):
+		return &applyconfigurationconfigv1alpha1.ConfigUpdateStrategyApplyConfiguration{}
+	case configv1alpha1.SchemeGroupVersion.WithKind("CustomNodeConfig"):
+		return &applyconfigurationconfigv1alpha1.CustomNodeConfigApplyConfiguration{}
+	case configv1alpha1.SchemeGroupVersion.WithKind("CustomNodeConfigStatus"):
+		return &applyconfigurationconfigv1alpha1.CustomNodeConfigStatusApplyConfiguration{}
+	case configv1alpha1.SchemeGroupVersion.WithKind("EphemeralSelector"):
+		return &applyconfigurationconfigv1alpha1.EphemeralSelectorApplyConfiguration{}
+	case configv1alpha1.SchemeGroupVersion.WithKind("GenericConfigCondition"):
+		return &applyconfigurationconfigv1alpha1.GenericConfigConditionApplyConfiguration{}
+	case configv1alpha1.SchemeGroupVersion.WithKind("GenericConfigSpec"):
+		return &applyconfigurationconfigv1alpha1.GenericConfigSpecApplyConfiguration{}
+	case configv1alpha1.SchemeGroupVersion.WithKind("GenericConfigStatus"):
+		return &applyconfigurationconfigv1alpha1.GenericConfigStatusApplyConfiguration{}
+	case configv1alpha1.SchemeGroupVersion.WithKind("GroupConfig"):
+		return &applyconfigurationconfigv1alpha1.GroupConfigApplyConfiguration{}
+	case configv1alpha1.SchemeGroupVersion.WithKind("KatalystCustomConfig"):
+		return &applyconfigurationconfigv1alpha1.KatalystCustomConfigApplyConfiguration{}
+	case configv1alpha1.SchemeGroupVersion.WithKind("KatalystCustomConfigCondition"):
+		return &applyconfigurationconfigv1alpha1.KatalystCustomConfigConditionApplyConfiguration{}
+	case configv1alpha1.SchemeGroupVersion.WithKind("KatalystCustomConfigSpec"):
+		return &applyconfigurationconfigv1alpha1.KatalystCustomConfigSpecApplyConfiguration{}
+	case configv1alpha1.SchemeGroupVersion.WithKind("KatalystCustomConfigStatus"):
+		return &applyconfigurationconfigv1alpha1.KatalystCustomConfigStatusApplyConfiguration{}
+	case configv1alpha1.SchemeGroupVersion.WithKind("PriorityNodeLabelSelectorAllowedKeyList"):
+		return &applyconfigurationconfigv1alpha1.PriorityNodeLabelSelectorAllowedKeyListApplyConfiguration{}
+	case configv1alpha1.SchemeGroupVersion.WithKind("RollingUpdateConfig"):
+		return &applyconfigurationconfigv1alpha1.RollingUpdateConfigApplyConfiguration{}
+	case configv1alpha1.SchemeGroupVersion.WithKind("Strategy"):
+		return &applyconfigurationconfigv1alpha1.StrategyApplyConfiguration{}
+	case configv1alpha1.SchemeGroupVersion.WithKind("StrategyGroup"):
+		return &applyconfigurationconfigv1alpha1.StrategyGroupApplyConfiguration{}
+	case configv1alpha1.SchemeGroupVersion.WithKind("StrategyGroupConfig"):
+		return &applyconfigurationconfigv1alpha1.StrategyGroupConfigApplyConfiguration{}
+	case configv1alpha1.SchemeGroupVersion.WithKind("StrategyGroupConfiguration"):
+		return &applyconfigurationconfigv1alpha1.StrategyGroupConfigurationApplyConfiguration{}
+	case configv1alpha1.SchemeGroupVersion.WithKind("StrategyGroupConfigurationSpec"):
+		return &applyconfigurationconfigv1alpha1.StrategyGroupConfigurationSpecApplyConfiguration{}
+	case configv1alpha1.SchemeGroupVersion.WithKind("StrategyGroupStatus"):
+		return &applyconfigurationconfigv1alpha1.StrategyGroupStatusApplyConfiguration{}
+	case configv1alpha1.SchemeGroupVersion.WithKind("TargetConfig"):
+		return &applyconfigurationconfigv1alpha1.TargetConfigApplyConfiguration{}
+	case configv1alpha1.SchemeGroupVersion.WithKind("TimeWindow"):
+		return &applyconfigurationconfigv1alpha1.TimeWindowApplyConfiguration{}
+
+		// Group=node.katalyst.kubewharf.io, Version=v1alpha1
+	case nodev1alpha1.SchemeGroupVersion.WithKind("Allocation"):
+		return &applyconfigurationnodev1alpha1.AllocationApplyConfiguration{}
+	case nodev1alpha1.SchemeGroupVersion.WithKind("Attribute"):
+		return &applyconfigurationnodev1alpha1.AttributeApplyConfiguration{}
+	case nodev1alpha1.SchemeGroupVersion.WithKind("CNRCondition"):
+		return &applyconfigurationnodev1alpha1.CNRConditionApplyConfiguration{}
+	case nodev1alpha1.SchemeGroupVersion.WithKind("CustomNodeResource"):
+		return &applyconfigurationnodev1alpha1.CustomNodeResourceApplyConfiguration{}
+	case nodev1alpha1.SchemeGroupVersion.WithKind("CustomNodeResourceSpec"):
+		return &applyconfigurationnodev1alpha1.CustomNodeResourceSpecApplyConfiguration{}
+	case nodev1alpha1.SchemeGroupVersion.WithKind("CustomNodeResourceStatus"):
+		return &applyconfigurationnodev1alpha1.CustomNodeResourceStatusApplyConfiguration{}
+	case nodev1alpha1.SchemeGroupVersion.WithKind("GroupMetricInfo"):
+		return &applyconfigurationnodev1alpha1.GroupMetricInfoApplyConfiguration{}
+	case nodev1alpha1.SchemeGroupVersion.WithKind("MetricDeclaration"):
+		return &applyconfigurationnodev1alpha1.MetricDeclarationApplyConfiguration{}
+	case nodev1alpha1.SchemeGroupVersion.WithKind("MetricSeries"):
+		return &applyconfigurationnodev1alpha1.MetricSeriesApplyConfiguration{}
+	case nodev1alpha1.SchemeGroupVersion.WithKind("MetricValue"):
+		return &applyconfigurationnodev1alpha1.MetricValueApplyConfiguration{}
+	case nodev1alpha1.SchemeGroupVersion.WithKind("NodeMetricInfo"):
+		return &applyconfigurationnodev1alpha1.NodeMetricInfoApplyConfiguration{}
+	case nodev1alpha1.SchemeGroupVersion.WithKind("NodeMetricStatus"):
+		return &applyconfigurationnodev1alpha1.NodeMetricStatusApplyConfiguration{}
+	case nodev1alpha1.SchemeGroupVersion.WithKind("NodeProfileDescriptor"):
+		return &applyconfigurationnodev1alpha1.NodeProfileDescriptorApplyConfiguration{}
+	case nodev1alpha1.SchemeGroupVersion.WithKind("NodeProfileDescriptorSpec"):
+		return &applyconfigurationnodev1alpha1.NodeProfileDescriptorSpecApplyConfiguration{}
+	case nodev1alpha1.SchemeGroupVersion.WithKind("NodeProfileDescriptorStatus"):
+		return &applyconfigurationnodev1alpha1.NodeProfileDescriptorStatusApplyConfiguration{}
+	case nodev1alpha1.SchemeGroupVersion.WithKind("NUMAMetricInfo"):
+		return &applyconfigurationnodev1alpha1.NUMAMetricInfoApplyConfiguration{}
+	case nodev1alpha1.SchemeGroupVersion.WithKind("PodMetric"):
+		return &applyconfigurationnodev1alpha1.PodMetricApplyConfiguration{}
+	case nodev1alpha1.SchemeGroupVersion.WithKind("Property"):
+		return &applyconfigurationnodev1alpha1.PropertyApplyConfiguration{}
+	case nodev1alpha1.SchemeGroupVersion.WithKind("ResourcePackage"):
+		return &applyconfigurationnodev1alpha1.ResourcePackageApplyConfiguration{}
+	case nodev1alpha1.SchemeGroupVersion.WithKind("ResourcePool"):
+		return &applyconfigurationnodev1alpha1.ResourcePoolApplyConfiguration{}
+	case nodev1alpha1.SchemeGroupVersion.WithKind("Resources"):
+		return &applyconfigurationnodev1alpha1.ResourcesApplyConfiguration{}
+	case nodev1alpha1.SchemeGroupVersion.WithKind("ResourceUsage"):
+		return &applyconfigurationnodev1alpha1.ResourceUsageApplyConfiguration{}
+	case nodev1alpha1.SchemeGroupVersion.WithKind("ScopedMetricDeclarations"):
+		return &applyconfigurationnodev1alpha1.ScopedMetricDeclarationsApplyConfiguration{}
+	case nodev1alpha1.SchemeGroupVersion.WithKind("ScopedNodeMetrics"):
+		return &applyconfigurationnodev1alpha1.ScopedNodeMetricsApplyConfiguration{}
+	case nodev1alpha1.SchemeGroupVersion.WithKind("ScopedPodMetrics"):
+		return &applyconfigurationnodev1alpha1.ScopedPodMetricsApplyConfiguration{}
+	case nodev1alpha1.SchemeGroupVersion.WithKind("Sibling"):
+		return &applyconfigurationnodev1alpha1.SiblingApplyConfiguration{}
+	case nodev1alpha1.SchemeGroupVersion.WithKind("Taint"):
+		return &applyconfigurationnodev1alpha1.TaintApplyConfiguration{}
+	case nodev1alpha1.SchemeGroupVersion.WithKind("TopologyZone"):
+		return &applyconfigurationnodev1alpha1.TopologyZoneApplyConfiguration{}
+
+		// Group=overcommit.katalyst.kubewharf.io, Version=v1alpha1
+	case overcommitv1alpha1.SchemeGroupVersion.WithKind("NodeOvercommitConfig"):
+		return &applyconfigurationovercommitv1alpha1.NodeOvercommitConfigApplyConfiguration{}
+	case overcommitv1alpha1.SchemeGroupVersion.WithKind("NodeOvercommitConfigSpec"):
+		return &applyconfigurationovercommitv1alpha1.NodeOvercommitConfigSpecApplyConfiguration{}
+	case overcommitv1alpha1.SchemeGroupVersion.WithKind("NodeOvercommitConfigStatus"):
+		return &applyconfigurationovercommitv1alpha1.NodeOvercommitConfigStatusApplyConfiguration{}
+
+		// Group=recommendation.katalyst.kubewharf.io, Version=v1alpha1
+	case recommendationv1alpha1.SchemeGroupVersion.WithKind("AlgorithmPolicy"):
+		return &applyconfigurationrecommendationv1alpha1.AlgorithmPolicyApplyConfiguration{}
+	case recommendationv1alpha1.SchemeGroupVersion.WithKind("ContainerControlledResourcesPolicy"):
+		return &applyconfigurationrecommendationv1alpha1.ContainerControlledResourcesPolicyApplyConfiguration{}
+	case recommendationv1alpha1.SchemeGroupVersion.WithKind("ContainerResourceList"):
+		return &applyconfigurationrecommendationv1alpha1.ContainerResourceListApplyConfiguration{}
+	case recommendationv1alpha1.SchemeGroupVersion.WithKind("ContainerResourcePolicy"):
+		return &applyconfigurationrecommendationv1alpha1.ContainerResourcePolicyApplyConfiguration{}
+	case recommendationv1alpha1.SchemeGroupVersion.WithKind("ContainerResources"):
+		return &applyconfigurationrecommendationv1alpha1.ContainerResourcesApplyConfiguration{}
+	case recommendationv1alpha1.SchemeGroupVersion.WithKind("CrossVersionObjectReference"):
+		return &applyconfigurationrecommendationv1alpha1.CrossVersionObjectReferenceApplyConfiguration{}
+	case recommendationv1alpha1.SchemeGroupVersion.WithKind("PodResources"):
+		return &applyconfigurationrecommendationv1alpha1.PodResourcesApplyConfiguration{}
+	case recommendationv1alpha1.SchemeGroupVersion.WithKind("RecommendResources"):
+		return &applyconfigurationrecommendationv1alpha1.RecommendResourcesApplyConfiguration{}
+	case recommendationv1alpha1.SchemeGroupVersion.WithKind("ResourcePolicy"):
+		return &applyconfigurationrecommendationv1alpha1.ResourcePolicyApplyConfiguration{}
+	case recommendationv1alpha1.SchemeGroupVersion.WithKind("ResourceRecommend"):
+		return &applyconfigurationrecommendationv1alpha1.ResourceRecommendApplyConfiguration{}
+	case recommendationv1alpha1.SchemeGroupVersion.WithKind("ResourceRecommendCondition"):
+		return &applyconfigurationrecommendationv1alpha1.ResourceRecommendConditionApplyConfiguration{}
+	case recommendationv1alpha1.SchemeGroupVersion.WithKind("ResourceRecommendSpec"):
+		return &applyconfigurationrecommendationv1alpha1.ResourceRecommendSpecApplyConfiguration{}
+	case recommendationv1alpha1.SchemeGroupVersion.WithKind("ResourceRecommendStatus"):
+		return &applyconfigurationrecommendationv1alpha1.ResourceRecommendStatusApplyConfiguration{}
+
+		// Group=tide.katalyst.kubewharf.io, Version=v1alpha1
+	case tidev1alpha1.SchemeGroupVersion.WithKind("EvictStrategy"):
+		return &applyconfigurationtidev1alpha1.EvictStrategyApplyConfiguration{}
+	case tidev1alpha1.SchemeGroupVersion.WithKind("LabelOption"):
+		return &applyconfigurationtidev1alpha1.LabelOptionApplyConfiguration{}
+	case tidev1alpha1.SchemeGroupVersion.WithKind("NodeConfigs"):
+		return &applyconfigurationtidev1alpha1.NodeConfigsApplyConfiguration{}
+	case tidev1alpha1.SchemeGroupVersion.WithKind("ReserveNodes"):
+		return &applyconfigurationtidev1alpha1.ReserveNodesApplyConfiguration{}
+	case tidev1alpha1.SchemeGroupVersion.WithKind("ReserveOptions"):
+		return &applyconfigurationtidev1alpha1.ReserveOptionsApplyConfiguration{}
+	case tidev1alpha1.SchemeGroupVersion.WithKind("TaintOption"):
+		return &applyconfigurationtidev1alpha1.TaintOptionApplyConfiguration{}
+	case tidev1alpha1.SchemeGroupVersion.WithKind("TideNodePool"):
+		return &applyconfigurationtidev1alpha1.TideNodePoolApplyConfiguration{}
+	case tidev1alpha1.SchemeGroupVersion.WithKind("TideNodePoolSpec"):
+		return &applyconfigurationtidev1alpha1.TideNodePoolSpecApplyConfiguration{}
+	case tidev1alpha1.SchemeGroupVersion.WithKind("TideNodePoolStatus"):
+		return &applyconfigurationtidev1alpha1.TideNodePoolStatusApplyConfiguration{}
+	case tidev1alpha1.SchemeGroupVersion.WithKind("TideNodes"):
+		return &applyconfigurationtidev1alpha1.TideNodesApplyConfiguration{}
+	case tidev1alpha1.SchemeGroupVersion.WithKind("Watermark"):
+		return &applyconfigurationtidev1alpha1.WatermarkApplyConfiguration{}
+
+		// Group=workload.katalyst.kubewharf.io, Version=v1alpha1
+	case workloadv1alpha1.SchemeGroupVersion.WithKind("AggPodMetrics"):
+		return &applyconfigurationworkloadv1alpha1.AggPodMetricsApplyConfiguration{}
+	case workloadv1alpha1.SchemeGroupVersion.WithKind("Indicator"):
+		return &applyconfigurationworkloadv1alpha1.IndicatorApplyConfiguration{}
+	case workloadv1alpha1.SchemeGroupVersion.WithKind("PodMetrics"):
+		return &applyconfigurationworkloadv1alpha1.PodMetricsApplyConfiguration{}
+	case workloadv1alpha1.SchemeGroupVersion.WithKind("ServiceBusinessIndicatorSpec"):
+		return &applyconfigurationworkloadv1alpha1.ServiceBusinessIndicatorSpecApplyConfiguration{}
+	case workloadv1alpha1.SchemeGroupVersion.WithKind("ServiceBusinessIndicatorStatus"):
+		return &applyconfigurationworkloadv1alpha1.ServiceBusinessIndicatorStatusApplyConfiguration{}
+	case workloadv1alpha1.SchemeGroupVersion.WithKind("ServiceExtendedIndicatorSpec"):
+		return &applyconfigurationworkloadv1alpha1.ServiceExtendedIndicatorSpecApplyConfiguration{}
+	case workloadv1alpha1.SchemeGroupVersion.WithKind("ServiceProfileDescriptor"):
+		return &applyconfigurationworkloadv1alpha1.ServiceProfileDescriptorApplyConfiguration{}
+	case workloadv1alpha1.SchemeGroupVersion.WithKind("ServiceProfileDescriptorSpec"):
+		return &applyconfigurationworkloadv1alpha1.ServiceProfileDescriptorSpecApplyConfiguration{}
+	case workloadv1alpha1.SchemeGroupVersion.WithKind("ServiceProfileDescriptorStatus"):
+		return &applyconfigurationworkloadv1alpha1.ServiceProfileDescriptorStatusApplyConfiguration{}
+	case workloadv1alpha1.SchemeGroupVersion.WithKind("ServiceSystemIndicatorSpec"):
+		return &applyconfigurationworkloadv1alpha1.ServiceSystemIndicatorSpecApplyConfiguration{}
+
+	}
+	return nil
+}
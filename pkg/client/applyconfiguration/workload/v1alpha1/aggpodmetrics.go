@@ -0,0 +1,66 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/workload/v1alpha1"
+)
+
+// AggPodMetricsApplyConfiguration represents an declarative configuration of the AggPodMetrics type for use
+// with apply.
+type AggPodMetricsApplyConfiguration struct {
+	Aggregator *v1alpha1.Aggregator           `json:"aggregator,omitempty"`
+	Scope      *string                        `json:"scope,omitempty"`
+	Items      []PodMetricsApplyConfiguration `json:"items,omitempty"`
+}
+
+// AggPodMetricsApplyConfiguration constructs an declarative configuration of the AggPodMetrics type for use with
+// apply.
+func AggPodMetrics() *AggPodMetricsApplyConfiguration {
+	return &AggPodMetricsApplyConfiguration{}
+}
+
+// WithAggregator sets the Aggregator field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Aggregator field is set to the value of the last call.
+func (b *AggPodMetricsApplyConfiguration) WithAggregator(value v1alpha1.Aggregator) *AggPodMetricsApplyConfiguration {
+	b.Aggregator = &value
+	return b
+}
+
+// WithScope sets the Scope field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Scope field is set to the value of the last call.
+func (b *AggPodMetricsApplyConfiguration) WithScope(value string) *AggPodMetricsApplyConfiguration {
+	b.Scope = &value
+	return b
+}
+
+// WithItems adds the given value to the Items field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Items field.
+func (b *AggPodMetricsApplyConfiguration) WithItems(values ...*PodMetricsApplyConfiguration) *AggPodMetricsApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithItems")
+		}
+		b.Items = append(b.Items, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/workload/v1alpha1"
+)
+
+// IndicatorApplyConfiguration represents an declarative configuration of the Indicator type for use
+// with apply.
+type IndicatorApplyConfiguration struct {
+	IndicatorLevel *v1alpha1.IndicatorLevelName `json:"indicatorLevel,omitempty"`
+	Value          *float32                     `json:"value,omitempty"`
+}
+
+// IndicatorApplyConfiguration constructs an declarative configuration of the Indicator type for use with
+// apply.
+func Indicator() *IndicatorApplyConfiguration {
+	return &IndicatorApplyConfiguration{}
+}
+
+// WithIndicatorLevel sets the IndicatorLevel field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IndicatorLevel field is set to the value of the last call.
+func (b *IndicatorApplyConfiguration) WithIndicatorLevel(value v1alpha1.IndicatorLevelName) *IndicatorApplyConfiguration {
+	b.IndicatorLevel = &value
+	return b
+}
+
+// WithValue sets the Value field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Value field is set to the value of the last call.
+func (b *IndicatorApplyConfiguration) WithValue(value float32) *IndicatorApplyConfiguration {
+	b.Value = &value
+	return b
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+// PodMetricsApplyConfiguration represents an declarative configuration of the PodMetrics type for use
+// with apply.
+type PodMetricsApplyConfiguration struct {
+	Annotations map[string]string          `json:"annotations,omitempty"`
+	Timestamp   *v1.Time                   `json:"timestamp,omitempty"`
+	Window      *v1.Duration               `json:"window,omitempty"`
+	Containers  []v1beta1.ContainerMetrics `json:"containers,omitempty"`
+}
+
+// PodMetricsApplyConfiguration constructs an declarative configuration of the PodMetrics type for use with
+// apply.
+func PodMetrics() *PodMetricsApplyConfiguration {
+	return &PodMetricsApplyConfiguration{}
+}
+
+// WithAnnotations puts the entries into the Annotations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Annotations field,
+// overwriting an existing map entries in Annotations field with the same key.
+func (b *PodMetricsApplyConfiguration) WithAnnotations(entries map[string]string) *PodMetricsApplyConfiguration {
+	if b.Annotations == nil && len(entries) > 0 {
+		b.Annotations = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.Annotations[k] = v
+	}
+	return b
+}
+
+// WithTimestamp sets the Timestamp field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Timestamp field is set to the value of the last call.
+func (b *PodMetricsApplyConfiguration) WithTimestamp(value v1.Time) *PodMetricsApplyConfiguration {
+	b.Timestamp = &value
+	return b
+}
+
+// WithWindow sets the Window field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Window field is set to the value of the last call.
+func (b *PodMetricsApplyConfiguration) WithWindow(value v1.Duration) *PodMetricsApplyConfiguration {
+	b.Window = &value
+	return b
+}
+
+// WithContainers adds the given value to the Containers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Containers field.
+func (b *PodMetricsApplyConfiguration) WithContainers(values ...v1beta1.ContainerMetrics) *PodMetricsApplyConfiguration {
+	for i := range values {
+		b.Containers = append(b.Containers, values[i])
+	}
+	return b
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/workload/v1alpha1"
+)
+
+// ServiceBusinessIndicatorSpecApplyConfiguration represents an declarative configuration of the ServiceBusinessIndicatorSpec type for use
+// with apply.
+type ServiceBusinessIndicatorSpecApplyConfiguration struct {
+	Name       *v1alpha1.ServiceBusinessIndicatorName `json:"name,omitempty"`
+	Indicators []IndicatorApplyConfiguration          `json:"indicators,omitempty"`
+}
+
+// ServiceBusinessIndicatorSpecApplyConfiguration constructs an declarative configuration of the ServiceBusinessIndicatorSpec type for use with
+// apply.
+func ServiceBusinessIndicatorSpec() *ServiceBusinessIndicatorSpecApplyConfiguration {
+	return &ServiceBusinessIndicatorSpecApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *ServiceBusinessIndicatorSpecApplyConfiguration) WithName(value v1alpha1.ServiceBusinessIndicatorName) *ServiceBusinessIndicatorSpecApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithIndicators adds the given value to the Indicators field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Indicators field.
+func (b *ServiceBusinessIndicatorSpecApplyConfiguration) WithIndicators(values ...*IndicatorApplyConfiguration) *ServiceBusinessIndicatorSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithIndicators")
+		}
+		b.Indicators = append(b.Indicators, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/workload/v1alpha1"
+)
+
+// ServiceBusinessIndicatorStatusApplyConfiguration represents an declarative configuration of the ServiceBusinessIndicatorStatus type for use
+// with apply.
+type ServiceBusinessIndicatorStatusApplyConfiguration struct {
+	Name    *v1alpha1.ServiceBusinessIndicatorName `json:"name,omitempty"`
+	Current *float32                               `json:"current,omitempty"`
+}
+
+// ServiceBusinessIndicatorStatusApplyConfiguration constructs an declarative configuration of the ServiceBusinessIndicatorStatus type for use with
+// apply.
+func ServiceBusinessIndicatorStatus() *ServiceBusinessIndicatorStatusApplyConfiguration {
+	return &ServiceBusinessIndicatorStatusApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *ServiceBusinessIndicatorStatusApplyConfiguration) WithName(value v1alpha1.ServiceBusinessIndicatorName) *ServiceBusinessIndicatorStatusApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithCurrent sets the Current field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Current field is set to the value of the last call.
+func (b *ServiceBusinessIndicatorStatusApplyConfiguration) WithCurrent(value float32) *ServiceBusinessIndicatorStatusApplyConfiguration {
+	b.Current = &value
+	return b
+}
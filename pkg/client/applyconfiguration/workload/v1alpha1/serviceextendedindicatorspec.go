@@ -0,0 +1,61 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// ServiceExtendedIndicatorSpecApplyConfiguration represents an declarative configuration of the ServiceExtendedIndicatorSpec type for use
+// with apply.
+type ServiceExtendedIndicatorSpecApplyConfiguration struct {
+	Name            *string               `json:"name,omitempty"`
+	BaselinePercent *int32                `json:"baselinePercent,omitempty"`
+	Indicators      *runtime.RawExtension `json:"indicators,omitempty"`
+}
+
+// ServiceExtendedIndicatorSpecApplyConfiguration constructs an declarative configuration of the ServiceExtendedIndicatorSpec type for use with
+// apply.
+func ServiceExtendedIndicatorSpec() *ServiceExtendedIndicatorSpecApplyConfiguration {
+	return &ServiceExtendedIndicatorSpecApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *ServiceExtendedIndicatorSpecApplyConfiguration) WithName(value string) *ServiceExtendedIndicatorSpecApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithBaselinePercent sets the BaselinePercent field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BaselinePercent field is set to the value of the last call.
+func (b *ServiceExtendedIndicatorSpecApplyConfiguration) WithBaselinePercent(value int32) *ServiceExtendedIndicatorSpecApplyConfiguration {
+	b.BaselinePercent = &value
+	return b
+}
+
+// WithIndicators sets the Indicators field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Indicators field is set to the value of the last call.
+func (b *ServiceExtendedIndicatorSpecApplyConfiguration) WithIndicators(value runtime.RawExtension) *ServiceExtendedIndicatorSpecApplyConfiguration {
+	b.Indicators = &value
+	return b
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/kubewharf/katalyst-api/pkg/client/applyconfiguration/autoscaling/v1alpha1"
+)
+
+// ServiceProfileDescriptorSpecApplyConfiguration represents an declarative configuration of the ServiceProfileDescriptorSpec type for use
+// with apply.
+type ServiceProfileDescriptorSpecApplyConfiguration struct {
+	TargetRef         *v1alpha1.CrossVersionObjectReferenceApplyConfiguration `json:"targetRef,omitempty"`
+	BaselinePercent   *int32                                                  `json:"baselinePercent,omitempty"`
+	ExtendedIndicator []ServiceExtendedIndicatorSpecApplyConfiguration        `json:"extendedIndicator,omitempty"`
+	BusinessIndicator []ServiceBusinessIndicatorSpecApplyConfiguration        `json:"businessIndicator,omitempty"`
+	SystemIndicator   []ServiceSystemIndicatorSpecApplyConfiguration          `json:"systemIndicator,omitempty"`
+}
+
+// ServiceProfileDescriptorSpecApplyConfiguration constructs an declarative configuration of the ServiceProfileDescriptorSpec type for use with
+// apply.
+func ServiceProfileDescriptorSpec() *ServiceProfileDescriptorSpecApplyConfiguration {
+	return &ServiceProfileDescriptorSpecApplyConfiguration{}
+}
+
+// WithTargetRef sets the TargetRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TargetRef field is set to the value of the last call.
+func (b *ServiceProfileDescriptorSpecApplyConfiguration) WithTargetRef(value *v1alpha1.CrossVersionObjectReferenceApplyConfiguration) *ServiceProfileDescriptorSpecApplyConfiguration {
+	b.TargetRef = value
+	return b
+}
+
+// WithBaselinePercent sets the BaselinePercent field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BaselinePercent field is set to the value of the last call.
+func (b *ServiceProfileDescriptorSpecApplyConfiguration) WithBaselinePercent(value int32) *ServiceProfileDescriptorSpecApplyConfiguration {
+	b.BaselinePercent = &value
+	return b
+}
+
+// WithExtendedIndicator adds the given value to the ExtendedIndicator field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ExtendedIndicator field.
+func (b *ServiceProfileDescriptorSpecApplyConfiguration) WithExtendedIndicator(values ...*ServiceExtendedIndicatorSpecApplyConfiguration) *ServiceProfileDescriptorSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithExtendedIndicator")
+		}
+		b.ExtendedIndicator = append(b.ExtendedIndicator, *values[i])
+	}
+	return b
+}
+
+// WithBusinessIndicator adds the given value to the BusinessIndicator field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the BusinessIndicator field.
+func (b *ServiceProfileDescriptorSpecApplyConfiguration) WithBusinessIndicator(values ...*ServiceBusinessIndicatorSpecApplyConfiguration) *ServiceProfileDescriptorSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithBusinessIndicator")
+		}
+		b.BusinessIndicator = append(b.BusinessIndicator, *values[i])
+	}
+	return b
+}
+
+// WithSystemIndicator adds the given value to the SystemIndicator field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the SystemIndicator field.
+func (b *ServiceProfileDescriptorSpecApplyConfiguration) WithSystemIndicator(values ...*ServiceSystemIndicatorSpecApplyConfiguration) *ServiceProfileDescriptorSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithSystemIndicator")
+		}
+		b.SystemIndicator = append(b.SystemIndicator, *values[i])
+	}
+	return b
+}
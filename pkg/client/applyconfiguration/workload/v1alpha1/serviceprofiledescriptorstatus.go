@@ -0,0 +1,58 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// ServiceProfileDescriptorStatusApplyConfiguration represents an declarative configuration of the ServiceProfileDescriptorStatus type for use
+// with apply.
+type ServiceProfileDescriptorStatusApplyConfiguration struct {
+	AggMetrics     []AggPodMetricsApplyConfiguration                  `json:"aggMetrics,omitempty"`
+	BusinessStatus []ServiceBusinessIndicatorStatusApplyConfiguration `json:"businessStatus,omitempty"`
+}
+
+// ServiceProfileDescriptorStatusApplyConfiguration constructs an declarative configuration of the ServiceProfileDescriptorStatus type for use with
+// apply.
+func ServiceProfileDescriptorStatus() *ServiceProfileDescriptorStatusApplyConfiguration {
+	return &ServiceProfileDescriptorStatusApplyConfiguration{}
+}
+
+// WithAggMetrics adds the given value to the AggMetrics field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AggMetrics field.
+func (b *ServiceProfileDescriptorStatusApplyConfiguration) WithAggMetrics(values ...*AggPodMetricsApplyConfiguration) *ServiceProfileDescriptorStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAggMetrics")
+		}
+		b.AggMetrics = append(b.AggMetrics, *values[i])
+	}
+	return b
+}
+
+// WithBusinessStatus adds the given value to the BusinessStatus field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the BusinessStatus field.
+func (b *ServiceProfileDescriptorStatusApplyConfiguration) WithBusinessStatus(values ...*ServiceBusinessIndicatorStatusApplyConfiguration) *ServiceProfileDescriptorStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithBusinessStatus")
+		}
+		b.BusinessStatus = append(b.BusinessStatus, *values[i])
+	}
+	return b
+}
@@ -20,8 +20,11 @@ package fake
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 
 	v1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/autoscaling/v1alpha1"
+	autoscalingv1alpha1 "github.com/kubewharf/katalyst-api/pkg/client/applyconfiguration/autoscaling/v1alpha1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
@@ -140,3 +143,48 @@ func (c *FakeVerticalPodAutoscalerRecommendations) Patch(ctx context.Context, na
 	}
 	return obj.(*v1alpha1.VerticalPodAutoscalerRecommendation), err
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied verticalPodAutoscalerRecommendation.
+func (c *FakeVerticalPodAutoscalerRecommendations) Apply(ctx context.Context, verticalPodAutoscalerRecommendation *autoscalingv1alpha1.VerticalPodAutoscalerRecommendationApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.VerticalPodAutoscalerRecommendation, err error) {
+	if verticalPodAutoscalerRecommendation == nil {
+		return nil, fmt.Errorf("verticalPodAutoscalerRecommendation provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(verticalPodAutoscalerRecommendation)
+	if err != nil {
+		return nil, err
+	}
+	name := verticalPodAutoscalerRecommendation.Name
+	if name == nil {
+		return nil, fmt.Errorf("verticalPodAutoscalerRecommendation.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(verticalpodautoscalerrecommendationsResource, c.ns, *name, types.ApplyPatchType, data), &v1alpha1.VerticalPodAutoscalerRecommendation{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.VerticalPodAutoscalerRecommendation), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeVerticalPodAutoscalerRecommendations) ApplyStatus(ctx context.Context, verticalPodAutoscalerRecommendation *autoscalingv1alpha1.VerticalPodAutoscalerRecommendationApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.VerticalPodAutoscalerRecommendation, err error) {
+	if verticalPodAutoscalerRecommendation == nil {
+		return nil, fmt.Errorf("verticalPodAutoscalerRecommendation provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(verticalPodAutoscalerRecommendation)
+	if err != nil {
+		return nil, err
+	}
+	name := verticalPodAutoscalerRecommendation.Name
+	if name == nil {
+		return nil, fmt.Errorf("verticalPodAutoscalerRecommendation.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(verticalpodautoscalerrecommendationsResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1alpha1.VerticalPodAutoscalerRecommendation{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.VerticalPodAutoscalerRecommendation), err
+}
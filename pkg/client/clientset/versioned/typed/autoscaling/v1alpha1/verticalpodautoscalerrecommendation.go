@@ -20,9 +20,12 @@ package v1alpha1
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 	"time"
 
 	v1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/autoscaling/v1alpha1"
+	autoscalingv1alpha1 "github.com/kubewharf/katalyst-api/pkg/client/applyconfiguration/autoscaling/v1alpha1"
 	scheme "github.com/kubewharf/katalyst-api/pkg/client/clientset/versioned/scheme"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
@@ -47,6 +50,8 @@ type VerticalPodAutoscalerRecommendationInterface interface {
 	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.VerticalPodAutoscalerRecommendationList, error)
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.VerticalPodAutoscalerRecommendation, err error)
+	Apply(ctx context.Context, verticalPodAutoscalerRecommendation *autoscalingv1alpha1.VerticalPodAutoscalerRecommendationApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.VerticalPodAutoscalerRecommendation, err error)
+	ApplyStatus(ctx context.Context, verticalPodAutoscalerRecommendation *autoscalingv1alpha1.VerticalPodAutoscalerRecommendationApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.VerticalPodAutoscalerRecommendation, err error)
 	VerticalPodAutoscalerRecommendationExpansion
 }
 
@@ -193,3 +198,59 @@ func (c *verticalPodAutoscalerRecommendations) Patch(ctx context.Context, name s
 		Into(result)
 	return
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied verticalPodAutoscalerRecommendation.
+func (c *verticalPodAutoscalerRecommendations) Apply(ctx context.Context, verticalPodAutoscalerRecommendation *autoscalingv1alpha1.VerticalPodAutoscalerRecommendationApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.VerticalPodAutoscalerRecommendation, err error) {
+	if verticalPodAutoscalerRecommendation == nil {
+		return nil, fmt.Errorf("verticalPodAutoscalerRecommendation provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(verticalPodAutoscalerRecommendation)
+	if err != nil {
+		return nil, err
+	}
+	name := verticalPodAutoscalerRecommendation.Name
+	if name == nil {
+		return nil, fmt.Errorf("verticalPodAutoscalerRecommendation.Name must be provided to Apply")
+	}
+	result = &v1alpha1.VerticalPodAutoscalerRecommendation{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("verticalpodautoscalerrecommendations").
+		Name(*name).
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *verticalPodAutoscalerRecommendations) ApplyStatus(ctx context.Context, verticalPodAutoscalerRecommendation *autoscalingv1alpha1.VerticalPodAutoscalerRecommendationApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.VerticalPodAutoscalerRecommendation, err error) {
+	if verticalPodAutoscalerRecommendation == nil {
+		return nil, fmt.Errorf("verticalPodAutoscalerRecommendation provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(verticalPodAutoscalerRecommendation)
+	if err != nil {
+		return nil, err
+	}
+
+	name := verticalPodAutoscalerRecommendation.Name
+	if name == nil {
+		return nil, fmt.Errorf("verticalPodAutoscalerRecommendation.Name must be provided to Apply")
+	}
+
+	result = &v1alpha1.VerticalPodAutoscalerRecommendation{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("verticalpodautoscalerrecommendations").
+		Name(*name).
+		SubResource("status").
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -20,8 +20,11 @@ package fake
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 
 	v1alpha2 "github.com/kubewharf/katalyst-api/pkg/apis/autoscaling/v1alpha2"
+	autoscalingv1alpha2 "github.com/kubewharf/katalyst-api/pkg/client/applyconfiguration/autoscaling/v1alpha2"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
@@ -140,3 +143,48 @@ func (c *FakeIntelligentHorizontalPodAutoscalers) Patch(ctx context.Context, nam
 	}
 	return obj.(*v1alpha2.IntelligentHorizontalPodAutoscaler), err
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied intelligentHorizontalPodAutoscaler.
+func (c *FakeIntelligentHorizontalPodAutoscalers) Apply(ctx context.Context, intelligentHorizontalPodAutoscaler *autoscalingv1alpha2.IntelligentHorizontalPodAutoscalerApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha2.IntelligentHorizontalPodAutoscaler, err error) {
+	if intelligentHorizontalPodAutoscaler == nil {
+		return nil, fmt.Errorf("intelligentHorizontalPodAutoscaler provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(intelligentHorizontalPodAutoscaler)
+	if err != nil {
+		return nil, err
+	}
+	name := intelligentHorizontalPodAutoscaler.Name
+	if name == nil {
+		return nil, fmt.Errorf("intelligentHorizontalPodAutoscaler.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(intelligenthorizontalpodautoscalersResource, c.ns, *name, types.ApplyPatchType, data), &v1alpha2.IntelligentHorizontalPodAutoscaler{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.IntelligentHorizontalPodAutoscaler), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeIntelligentHorizontalPodAutoscalers) ApplyStatus(ctx context.Context, intelligentHorizontalPodAutoscaler *autoscalingv1alpha2.IntelligentHorizontalPodAutoscalerApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha2.IntelligentHorizontalPodAutoscaler, err error) {
+	if intelligentHorizontalPodAutoscaler == nil {
+		return nil, fmt.Errorf("intelligentHorizontalPodAutoscaler provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(intelligentHorizontalPodAutoscaler)
+	if err != nil {
+		return nil, err
+	}
+	name := intelligentHorizontalPodAutoscaler.Name
+	if name == nil {
+		return nil, fmt.Errorf("intelligentHorizontalPodAutoscaler.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(intelligenthorizontalpodautoscalersResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1alpha2.IntelligentHorizontalPodAutoscaler{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.IntelligentHorizontalPodAutoscaler), err
+}
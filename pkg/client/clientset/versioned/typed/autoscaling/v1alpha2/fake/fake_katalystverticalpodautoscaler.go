@@ -20,8 +20,11 @@ package fake
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 
 	v1alpha2 "github.com/kubewharf/katalyst-api/pkg/apis/autoscaling/v1alpha2"
+	autoscalingv1alpha2 "github.com/kubewharf/katalyst-api/pkg/client/applyconfiguration/autoscaling/v1alpha2"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
@@ -140,3 +143,48 @@ func (c *FakeKatalystVerticalPodAutoscalers) Patch(ctx context.Context, name str
 	}
 	return obj.(*v1alpha2.KatalystVerticalPodAutoscaler), err
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied katalystVerticalPodAutoscaler.
+func (c *FakeKatalystVerticalPodAutoscalers) Apply(ctx context.Context, katalystVerticalPodAutoscaler *autoscalingv1alpha2.KatalystVerticalPodAutoscalerApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha2.KatalystVerticalPodAutoscaler, err error) {
+	if katalystVerticalPodAutoscaler == nil {
+		return nil, fmt.Errorf("katalystVerticalPodAutoscaler provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(katalystVerticalPodAutoscaler)
+	if err != nil {
+		return nil, err
+	}
+	name := katalystVerticalPodAutoscaler.Name
+	if name == nil {
+		return nil, fmt.Errorf("katalystVerticalPodAutoscaler.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(katalystverticalpodautoscalersResource, c.ns, *name, types.ApplyPatchType, data), &v1alpha2.KatalystVerticalPodAutoscaler{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.KatalystVerticalPodAutoscaler), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeKatalystVerticalPodAutoscalers) ApplyStatus(ctx context.Context, katalystVerticalPodAutoscaler *autoscalingv1alpha2.KatalystVerticalPodAutoscalerApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha2.KatalystVerticalPodAutoscaler, err error) {
+	if katalystVerticalPodAutoscaler == nil {
+		return nil, fmt.Errorf("katalystVerticalPodAutoscaler provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(katalystVerticalPodAutoscaler)
+	if err != nil {
+		return nil, err
+	}
+	name := katalystVerticalPodAutoscaler.Name
+	if name == nil {
+		return nil, fmt.Errorf("katalystVerticalPodAutoscaler.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(katalystverticalpodautoscalersResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1alpha2.KatalystVerticalPodAutoscaler{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.KatalystVerticalPodAutoscaler), err
+}
@@ -20,8 +20,11 @@ package fake
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 
 	v1alpha2 "github.com/kubewharf/katalyst-api/pkg/apis/autoscaling/v1alpha2"
+	autoscalingv1alpha2 "github.com/kubewharf/katalyst-api/pkg/client/applyconfiguration/autoscaling/v1alpha2"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
@@ -140,3 +143,48 @@ func (c *FakeVirtualWorkloads) Patch(ctx context.Context, name string, pt types.
 	}
 	return obj.(*v1alpha2.VirtualWorkload), err
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied virtualWorkload.
+func (c *FakeVirtualWorkloads) Apply(ctx context.Context, virtualWorkload *autoscalingv1alpha2.VirtualWorkloadApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha2.VirtualWorkload, err error) {
+	if virtualWorkload == nil {
+		return nil, fmt.Errorf("virtualWorkload provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(virtualWorkload)
+	if err != nil {
+		return nil, err
+	}
+	name := virtualWorkload.Name
+	if name == nil {
+		return nil, fmt.Errorf("virtualWorkload.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(virtualworkloadsResource, c.ns, *name, types.ApplyPatchType, data), &v1alpha2.VirtualWorkload{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.VirtualWorkload), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeVirtualWorkloads) ApplyStatus(ctx context.Context, virtualWorkload *autoscalingv1alpha2.VirtualWorkloadApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha2.VirtualWorkload, err error) {
+	if virtualWorkload == nil {
+		return nil, fmt.Errorf("virtualWorkload provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(virtualWorkload)
+	if err != nil {
+		return nil, err
+	}
+	name := virtualWorkload.Name
+	if name == nil {
+		return nil, fmt.Errorf("virtualWorkload.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(virtualworkloadsResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1alpha2.VirtualWorkload{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.VirtualWorkload), err
+}
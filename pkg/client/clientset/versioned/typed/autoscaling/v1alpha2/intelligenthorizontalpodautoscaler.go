@@ -20,9 +20,12 @@ package v1alpha2
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 	"time"
 
 	v1alpha2 "github.com/kubewharf/katalyst-api/pkg/apis/autoscaling/v1alpha2"
+	autoscalingv1alpha2 "github.com/kubewharf/katalyst-api/pkg/client/applyconfiguration/autoscaling/v1alpha2"
 	scheme "github.com/kubewharf/katalyst-api/pkg/client/clientset/versioned/scheme"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
@@ -47,6 +50,8 @@ type IntelligentHorizontalPodAutoscalerInterface interface {
 	List(ctx context.Context, opts v1.ListOptions) (*v1alpha2.IntelligentHorizontalPodAutoscalerList, error)
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha2.IntelligentHorizontalPodAutoscaler, err error)
+	Apply(ctx context.Context, intelligentHorizontalPodAutoscaler *autoscalingv1alpha2.IntelligentHorizontalPodAutoscalerApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha2.IntelligentHorizontalPodAutoscaler, err error)
+	ApplyStatus(ctx context.Context, intelligentHorizontalPodAutoscaler *autoscalingv1alpha2.IntelligentHorizontalPodAutoscalerApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha2.IntelligentHorizontalPodAutoscaler, err error)
 	IntelligentHorizontalPodAutoscalerExpansion
 }
 
@@ -193,3 +198,59 @@ func (c *intelligentHorizontalPodAutoscalers) Patch(ctx context.Context, name st
 		Into(result)
 	return
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied intelligentHorizontalPodAutoscaler.
+func (c *intelligentHorizontalPodAutoscalers) Apply(ctx context.Context, intelligentHorizontalPodAutoscaler *autoscalingv1alpha2.IntelligentHorizontalPodAutoscalerApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha2.IntelligentHorizontalPodAutoscaler, err error) {
+	if intelligentHorizontalPodAutoscaler == nil {
+		return nil, fmt.Errorf("intelligentHorizontalPodAutoscaler provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(intelligentHorizontalPodAutoscaler)
+	if err != nil {
+		return nil, err
+	}
+	name := intelligentHorizontalPodAutoscaler.Name
+	if name == nil {
+		return nil, fmt.Errorf("intelligentHorizontalPodAutoscaler.Name must be provided to Apply")
+	}
+	result = &v1alpha2.IntelligentHorizontalPodAutoscaler{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("intelligenthorizontalpodautoscalers").
+		Name(*name).
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *intelligentHorizontalPodAutoscalers) ApplyStatus(ctx context.Context, intelligentHorizontalPodAutoscaler *autoscalingv1alpha2.IntelligentHorizontalPodAutoscalerApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha2.IntelligentHorizontalPodAutoscaler, err error) {
+	if intelligentHorizontalPodAutoscaler == nil {
+		return nil, fmt.Errorf("intelligentHorizontalPodAutoscaler provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(intelligentHorizontalPodAutoscaler)
+	if err != nil {
+		return nil, err
+	}
+
+	name := intelligentHorizontalPodAutoscaler.Name
+	if name == nil {
+		return nil, fmt.Errorf("intelligentHorizontalPodAutoscaler.Name must be provided to Apply")
+	}
+
+	result = &v1alpha2.IntelligentHorizontalPodAutoscaler{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("intelligenthorizontalpodautoscalers").
+		Name(*name).
+		SubResource("status").
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -20,9 +20,12 @@ package v1alpha2
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 	"time"
 
 	v1alpha2 "github.com/kubewharf/katalyst-api/pkg/apis/autoscaling/v1alpha2"
+	autoscalingv1alpha2 "github.com/kubewharf/katalyst-api/pkg/client/applyconfiguration/autoscaling/v1alpha2"
 	scheme "github.com/kubewharf/katalyst-api/pkg/client/clientset/versioned/scheme"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
@@ -47,6 +50,8 @@ type VirtualWorkloadInterface interface {
 	List(ctx context.Context, opts v1.ListOptions) (*v1alpha2.VirtualWorkloadList, error)
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha2.VirtualWorkload, err error)
+	Apply(ctx context.Context, virtualWorkload *autoscalingv1alpha2.VirtualWorkloadApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha2.VirtualWorkload, err error)
+	ApplyStatus(ctx context.Context, virtualWorkload *autoscalingv1alpha2.VirtualWorkloadApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha2.VirtualWorkload, err error)
 	VirtualWorkloadExpansion
 }
 
@@ -193,3 +198,59 @@ func (c *virtualWorkloads) Patch(ctx context.Context, name string, pt types.Patc
 		Into(result)
 	return
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied virtualWorkload.
+func (c *virtualWorkloads) Apply(ctx context.Context, virtualWorkload *autoscalingv1alpha2.VirtualWorkloadApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha2.VirtualWorkload, err error) {
+	if virtualWorkload == nil {
+		return nil, fmt.Errorf("virtualWorkload provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(virtualWorkload)
+	if err != nil {
+		return nil, err
+	}
+	name := virtualWorkload.Name
+	if name == nil {
+		return nil, fmt.Errorf("virtualWorkload.Name must be provided to Apply")
+	}
+	result = &v1alpha2.VirtualWorkload{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("virtualworkloads").
+		Name(*name).
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *virtualWorkloads) ApplyStatus(ctx context.Context, virtualWorkload *autoscalingv1alpha2.VirtualWorkloadApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha2.VirtualWorkload, err error) {
+	if virtualWorkload == nil {
+		return nil, fmt.Errorf("virtualWorkload provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(virtualWorkload)
+	if err != nil {
+		return nil, err
+	}
+
+	name := virtualWorkload.Name
+	if name == nil {
+		return nil, fmt.Errorf("virtualWorkload.Name must be provided to Apply")
+	}
+
+	result = &v1alpha2.VirtualWorkload{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("virtualworkloads").
+		Name(*name).
+		SubResource("status").
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
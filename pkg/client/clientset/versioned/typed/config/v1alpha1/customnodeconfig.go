@@ -20,9 +20,12 @@ package v1alpha1
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 	"time"
 
 	v1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/config/v1alpha1"
+	configv1alpha1 "github.com/kubewharf/katalyst-api/pkg/client/applyconfiguration/config/v1alpha1"
 	scheme "github.com/kubewharf/katalyst-api/pkg/client/clientset/versioned/scheme"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
@@ -47,6 +50,8 @@ type CustomNodeConfigInterface interface {
 	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.CustomNodeConfigList, error)
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.CustomNodeConfig, err error)
+	Apply(ctx context.Context, customNodeConfig *configv1alpha1.CustomNodeConfigApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.CustomNodeConfig, err error)
+	ApplyStatus(ctx context.Context, customNodeConfig *configv1alpha1.CustomNodeConfigApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.CustomNodeConfig, err error)
 	CustomNodeConfigExpansion
 }
 
@@ -182,3 +187,57 @@ func (c *customNodeConfigs) Patch(ctx context.Context, name string, pt types.Pat
 		Into(result)
 	return
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied customNodeConfig.
+func (c *customNodeConfigs) Apply(ctx context.Context, customNodeConfig *configv1alpha1.CustomNodeConfigApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.CustomNodeConfig, err error) {
+	if customNodeConfig == nil {
+		return nil, fmt.Errorf("customNodeConfig provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(customNodeConfig)
+	if err != nil {
+		return nil, err
+	}
+	name := customNodeConfig.Name
+	if name == nil {
+		return nil, fmt.Errorf("customNodeConfig.Name must be provided to Apply")
+	}
+	result = &v1alpha1.CustomNodeConfig{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Resource("customnodeconfigs").
+		Name(*name).
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *customNodeConfigs) ApplyStatus(ctx context.Context, customNodeConfig *configv1alpha1.CustomNodeConfigApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.CustomNodeConfig, err error) {
+	if customNodeConfig == nil {
+		return nil, fmt.Errorf("customNodeConfig provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(customNodeConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	name := customNodeConfig.Name
+	if name == nil {
+		return nil, fmt.Errorf("customNodeConfig.Name must be provided to Apply")
+	}
+
+	result = &v1alpha1.CustomNodeConfig{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Resource("customnodeconfigs").
+		Name(*name).
+		SubResource("status").
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -20,8 +20,11 @@ package fake
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 
 	v1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/config/v1alpha1"
+	configv1alpha1 "github.com/kubewharf/katalyst-api/pkg/client/applyconfiguration/config/v1alpha1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
@@ -140,3 +143,48 @@ func (c *FakeKatalystCustomConfigs) Patch(ctx context.Context, name string, pt t
 	}
 	return obj.(*v1alpha1.KatalystCustomConfig), err
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied katalystCustomConfig.
+func (c *FakeKatalystCustomConfigs) Apply(ctx context.Context, katalystCustomConfig *configv1alpha1.KatalystCustomConfigApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.KatalystCustomConfig, err error) {
+	if katalystCustomConfig == nil {
+		return nil, fmt.Errorf("katalystCustomConfig provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(katalystCustomConfig)
+	if err != nil {
+		return nil, err
+	}
+	name := katalystCustomConfig.Name
+	if name == nil {
+		return nil, fmt.Errorf("katalystCustomConfig.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(katalystcustomconfigsResource, c.ns, *name, types.ApplyPatchType, data), &v1alpha1.KatalystCustomConfig{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.KatalystCustomConfig), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeKatalystCustomConfigs) ApplyStatus(ctx context.Context, katalystCustomConfig *configv1alpha1.KatalystCustomConfigApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.KatalystCustomConfig, err error) {
+	if katalystCustomConfig == nil {
+		return nil, fmt.Errorf("katalystCustomConfig provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(katalystCustomConfig)
+	if err != nil {
+		return nil, err
+	}
+	name := katalystCustomConfig.Name
+	if name == nil {
+		return nil, fmt.Errorf("katalystCustomConfig.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(katalystcustomconfigsResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1alpha1.KatalystCustomConfig{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.KatalystCustomConfig), err
+}
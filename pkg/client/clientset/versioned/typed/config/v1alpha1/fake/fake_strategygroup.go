@@ -20,8 +20,11 @@ package fake
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 
 	v1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/config/v1alpha1"
+	configv1alpha1 "github.com/kubewharf/katalyst-api/pkg/client/applyconfiguration/config/v1alpha1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
@@ -131,3 +134,46 @@ func (c *FakeStrategyGroups) Patch(ctx context.Context, name string, pt types.Pa
 	}
 	return obj.(*v1alpha1.StrategyGroup), err
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied strategyGroup.
+func (c *FakeStrategyGroups) Apply(ctx context.Context, strategyGroup *configv1alpha1.StrategyGroupApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.StrategyGroup, err error) {
+	if strategyGroup == nil {
+		return nil, fmt.Errorf("strategyGroup provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(strategyGroup)
+	if err != nil {
+		return nil, err
+	}
+	name := strategyGroup.Name
+	if name == nil {
+		return nil, fmt.Errorf("strategyGroup.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(strategygroupsResource, *name, types.ApplyPatchType, data), &v1alpha1.StrategyGroup{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.StrategyGroup), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeStrategyGroups) ApplyStatus(ctx context.Context, strategyGroup *configv1alpha1.StrategyGroupApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.StrategyGroup, err error) {
+	if strategyGroup == nil {
+		return nil, fmt.Errorf("strategyGroup provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(strategyGroup)
+	if err != nil {
+		return nil, err
+	}
+	name := strategyGroup.Name
+	if name == nil {
+		return nil, fmt.Errorf("strategyGroup.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(strategygroupsResource, *name, types.ApplyPatchType, data, "status"), &v1alpha1.StrategyGroup{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.StrategyGroup), err
+}
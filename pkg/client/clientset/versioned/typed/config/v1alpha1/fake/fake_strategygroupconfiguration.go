@@ -20,8 +20,11 @@ package fake
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 
 	v1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/config/v1alpha1"
+	configv1alpha1 "github.com/kubewharf/katalyst-api/pkg/client/applyconfiguration/config/v1alpha1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
@@ -131,3 +134,46 @@ func (c *FakeStrategyGroupConfigurations) Patch(ctx context.Context, name string
 	}
 	return obj.(*v1alpha1.StrategyGroupConfiguration), err
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied strategyGroupConfiguration.
+func (c *FakeStrategyGroupConfigurations) Apply(ctx context.Context, strategyGroupConfiguration *configv1alpha1.StrategyGroupConfigurationApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.StrategyGroupConfiguration, err error) {
+	if strategyGroupConfiguration == nil {
+		return nil, fmt.Errorf("strategyGroupConfiguration provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(strategyGroupConfiguration)
+	if err != nil {
+		return nil, err
+	}
+	name := strategyGroupConfiguration.Name
+	if name == nil {
+		return nil, fmt.Errorf("strategyGroupConfiguration.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(strategygroupconfigurationsResource, *name, types.ApplyPatchType, data), &v1alpha1.StrategyGroupConfiguration{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.StrategyGroupConfiguration), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeStrategyGroupConfigurations) ApplyStatus(ctx context.Context, strategyGroupConfiguration *configv1alpha1.StrategyGroupConfigurationApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.StrategyGroupConfiguration, err error) {
+	if strategyGroupConfiguration == nil {
+		return nil, fmt.Errorf("strategyGroupConfiguration provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(strategyGroupConfiguration)
+	if err != nil {
+		return nil, err
+	}
+	name := strategyGroupConfiguration.Name
+	if name == nil {
+		return nil, fmt.Errorf("strategyGroupConfiguration.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(strategygroupconfigurationsResource, *name, types.ApplyPatchType, data, "status"), &v1alpha1.StrategyGroupConfiguration{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.StrategyGroupConfiguration), err
+}
@@ -20,9 +20,12 @@ package v1alpha1
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 	"time"
 
 	v1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/node/v1alpha1"
+	nodev1alpha1 "github.com/kubewharf/katalyst-api/pkg/client/applyconfiguration/node/v1alpha1"
 	scheme "github.com/kubewharf/katalyst-api/pkg/client/clientset/versioned/scheme"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
@@ -47,6 +50,8 @@ type CustomNodeResourceInterface interface {
 	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.CustomNodeResourceList, error)
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.CustomNodeResource, err error)
+	Apply(ctx context.Context, customNodeResource *nodev1alpha1.CustomNodeResourceApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.CustomNodeResource, err error)
+	ApplyStatus(ctx context.Context, customNodeResource *nodev1alpha1.CustomNodeResourceApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.CustomNodeResource, err error)
 	CustomNodeResourceExpansion
 }
 
@@ -182,3 +187,57 @@ func (c *customNodeResources) Patch(ctx context.Context, name string, pt types.P
 		Into(result)
 	return
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied customNodeResource.
+func (c *customNodeResources) Apply(ctx context.Context, customNodeResource *nodev1alpha1.CustomNodeResourceApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.CustomNodeResource, err error) {
+	if customNodeResource == nil {
+		return nil, fmt.Errorf("customNodeResource provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(customNodeResource)
+	if err != nil {
+		return nil, err
+	}
+	name := customNodeResource.Name
+	if name == nil {
+		return nil, fmt.Errorf("customNodeResource.Name must be provided to Apply")
+	}
+	result = &v1alpha1.CustomNodeResource{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Resource("customnoderesources").
+		Name(*name).
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *customNodeResources) ApplyStatus(ctx context.Context, customNodeResource *nodev1alpha1.CustomNodeResourceApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.CustomNodeResource, err error) {
+	if customNodeResource == nil {
+		return nil, fmt.Errorf("customNodeResource provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(customNodeResource)
+	if err != nil {
+		return nil, err
+	}
+
+	name := customNodeResource.Name
+	if name == nil {
+		return nil, fmt.Errorf("customNodeResource.Name must be provided to Apply")
+	}
+
+	result = &v1alpha1.CustomNodeResource{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Resource("customnoderesources").
+		Name(*name).
+		SubResource("status").
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -20,8 +20,11 @@ package fake
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 
 	v1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/node/v1alpha1"
+	nodev1alpha1 "github.com/kubewharf/katalyst-api/pkg/client/applyconfiguration/node/v1alpha1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
@@ -131,3 +134,46 @@ func (c *FakeCustomNodeResources) Patch(ctx context.Context, name string, pt typ
 	}
 	return obj.(*v1alpha1.CustomNodeResource), err
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied customNodeResource.
+func (c *FakeCustomNodeResources) Apply(ctx context.Context, customNodeResource *nodev1alpha1.CustomNodeResourceApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.CustomNodeResource, err error) {
+	if customNodeResource == nil {
+		return nil, fmt.Errorf("customNodeResource provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(customNodeResource)
+	if err != nil {
+		return nil, err
+	}
+	name := customNodeResource.Name
+	if name == nil {
+		return nil, fmt.Errorf("customNodeResource.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(customnoderesourcesResource, *name, types.ApplyPatchType, data), &v1alpha1.CustomNodeResource{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.CustomNodeResource), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeCustomNodeResources) ApplyStatus(ctx context.Context, customNodeResource *nodev1alpha1.CustomNodeResourceApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.CustomNodeResource, err error) {
+	if customNodeResource == nil {
+		return nil, fmt.Errorf("customNodeResource provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(customNodeResource)
+	if err != nil {
+		return nil, err
+	}
+	name := customNodeResource.Name
+	if name == nil {
+		return nil, fmt.Errorf("customNodeResource.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(customnoderesourcesResource, *name, types.ApplyPatchType, data, "status"), &v1alpha1.CustomNodeResource{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.CustomNodeResource), err
+}
@@ -20,8 +20,11 @@ package fake
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 
 	v1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/node/v1alpha1"
+	nodev1alpha1 "github.com/kubewharf/katalyst-api/pkg/client/applyconfiguration/node/v1alpha1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
@@ -131,3 +134,46 @@ func (c *FakeNodeProfileDescriptors) Patch(ctx context.Context, name string, pt
 	}
 	return obj.(*v1alpha1.NodeProfileDescriptor), err
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied nodeProfileDescriptor.
+func (c *FakeNodeProfileDescriptors) Apply(ctx context.Context, nodeProfileDescriptor *nodev1alpha1.NodeProfileDescriptorApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.NodeProfileDescriptor, err error) {
+	if nodeProfileDescriptor == nil {
+		return nil, fmt.Errorf("nodeProfileDescriptor provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(nodeProfileDescriptor)
+	if err != nil {
+		return nil, err
+	}
+	name := nodeProfileDescriptor.Name
+	if name == nil {
+		return nil, fmt.Errorf("nodeProfileDescriptor.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(nodeprofiledescriptorsResource, *name, types.ApplyPatchType, data), &v1alpha1.NodeProfileDescriptor{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.NodeProfileDescriptor), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeNodeProfileDescriptors) ApplyStatus(ctx context.Context, nodeProfileDescriptor *nodev1alpha1.NodeProfileDescriptorApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.NodeProfileDescriptor, err error) {
+	if nodeProfileDescriptor == nil {
+		return nil, fmt.Errorf("nodeProfileDescriptor provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(nodeProfileDescriptor)
+	if err != nil {
+		return nil, err
+	}
+	name := nodeProfileDescriptor.Name
+	if name == nil {
+		return nil, fmt.Errorf("nodeProfileDescriptor.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(nodeprofiledescriptorsResource, *name, types.ApplyPatchType, data, "status"), &v1alpha1.NodeProfileDescriptor{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.NodeProfileDescriptor), err
+}
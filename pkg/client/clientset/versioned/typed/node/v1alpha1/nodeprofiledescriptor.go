@@ -20,9 +20,12 @@ package v1alpha1
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 	"time"
 
 	v1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/node/v1alpha1"
+	nodev1alpha1 "github.com/kubewharf/katalyst-api/pkg/client/applyconfiguration/node/v1alpha1"
 	scheme "github.com/kubewharf/katalyst-api/pkg/client/clientset/versioned/scheme"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
@@ -47,6 +50,8 @@ type NodeProfileDescriptorInterface interface {
 	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.NodeProfileDescriptorList, error)
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.NodeProfileDescriptor, err error)
+	Apply(ctx context.Context, nodeProfileDescriptor *nodev1alpha1.NodeProfileDescriptorApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.NodeProfileDescriptor, err error)
+	ApplyStatus(ctx context.Context, nodeProfileDescriptor *nodev1alpha1.NodeProfileDescriptorApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.NodeProfileDescriptor, err error)
 	NodeProfileDescriptorExpansion
 }
 
@@ -182,3 +187,57 @@ func (c *nodeProfileDescriptors) Patch(ctx context.Context, name string, pt type
 		Into(result)
 	return
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied nodeProfileDescriptor.
+func (c *nodeProfileDescriptors) Apply(ctx context.Context, nodeProfileDescriptor *nodev1alpha1.NodeProfileDescriptorApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.NodeProfileDescriptor, err error) {
+	if nodeProfileDescriptor == nil {
+		return nil, fmt.Errorf("nodeProfileDescriptor provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(nodeProfileDescriptor)
+	if err != nil {
+		return nil, err
+	}
+	name := nodeProfileDescriptor.Name
+	if name == nil {
+		return nil, fmt.Errorf("nodeProfileDescriptor.Name must be provided to Apply")
+	}
+	result = &v1alpha1.NodeProfileDescriptor{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Resource("nodeprofiledescriptors").
+		Name(*name).
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *nodeProfileDescriptors) ApplyStatus(ctx context.Context, nodeProfileDescriptor *nodev1alpha1.NodeProfileDescriptorApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.NodeProfileDescriptor, err error) {
+	if nodeProfileDescriptor == nil {
+		return nil, fmt.Errorf("nodeProfileDescriptor provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(nodeProfileDescriptor)
+	if err != nil {
+		return nil, err
+	}
+
+	name := nodeProfileDescriptor.Name
+	if name == nil {
+		return nil, fmt.Errorf("nodeProfileDescriptor.Name must be provided to Apply")
+	}
+
+	result = &v1alpha1.NodeProfileDescriptor{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Resource("nodeprofiledescriptors").
+		Name(*name).
+		SubResource("status").
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
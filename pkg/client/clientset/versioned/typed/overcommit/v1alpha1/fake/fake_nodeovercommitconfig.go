@@ -20,8 +20,11 @@ package fake
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 
 	v1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/overcommit/v1alpha1"
+	overcommitv1alpha1 "github.com/kubewharf/katalyst-api/pkg/client/applyconfiguration/overcommit/v1alpha1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
@@ -131,3 +134,46 @@ func (c *FakeNodeOvercommitConfigs) Patch(ctx context.Context, name string, pt t
 	}
 	return obj.(*v1alpha1.NodeOvercommitConfig), err
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied nodeOvercommitConfig.
+func (c *FakeNodeOvercommitConfigs) Apply(ctx context.Context, nodeOvercommitConfig *overcommitv1alpha1.NodeOvercommitConfigApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.NodeOvercommitConfig, err error) {
+	if nodeOvercommitConfig == nil {
+		return nil, fmt.Errorf("nodeOvercommitConfig provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(nodeOvercommitConfig)
+	if err != nil {
+		return nil, err
+	}
+	name := nodeOvercommitConfig.Name
+	if name == nil {
+		return nil, fmt.Errorf("nodeOvercommitConfig.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(nodeovercommitconfigsResource, *name, types.ApplyPatchType, data), &v1alpha1.NodeOvercommitConfig{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.NodeOvercommitConfig), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeNodeOvercommitConfigs) ApplyStatus(ctx context.Context, nodeOvercommitConfig *overcommitv1alpha1.NodeOvercommitConfigApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.NodeOvercommitConfig, err error) {
+	if nodeOvercommitConfig == nil {
+		return nil, fmt.Errorf("nodeOvercommitConfig provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(nodeOvercommitConfig)
+	if err != nil {
+		return nil, err
+	}
+	name := nodeOvercommitConfig.Name
+	if name == nil {
+		return nil, fmt.Errorf("nodeOvercommitConfig.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(nodeovercommitconfigsResource, *name, types.ApplyPatchType, data, "status"), &v1alpha1.NodeOvercommitConfig{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.NodeOvercommitConfig), err
+}
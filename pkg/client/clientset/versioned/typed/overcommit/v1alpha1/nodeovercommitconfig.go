@@ -20,9 +20,12 @@ package v1alpha1
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 	"time"
 
 	v1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/overcommit/v1alpha1"
+	overcommitv1alpha1 "github.com/kubewharf/katalyst-api/pkg/client/applyconfiguration/overcommit/v1alpha1"
 	scheme "github.com/kubewharf/katalyst-api/pkg/client/clientset/versioned/scheme"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
@@ -47,6 +50,8 @@ type NodeOvercommitConfigInterface interface {
 	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.NodeOvercommitConfigList, error)
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.NodeOvercommitConfig, err error)
+	Apply(ctx context.Context, nodeOvercommitConfig *overcommitv1alpha1.NodeOvercommitConfigApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.NodeOvercommitConfig, err error)
+	ApplyStatus(ctx context.Context, nodeOvercommitConfig *overcommitv1alpha1.NodeOvercommitConfigApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.NodeOvercommitConfig, err error)
 	NodeOvercommitConfigExpansion
 }
 
@@ -182,3 +187,57 @@ func (c *nodeOvercommitConfigs) Patch(ctx context.Context, name string, pt types
 		Into(result)
 	return
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied nodeOvercommitConfig.
+func (c *nodeOvercommitConfigs) Apply(ctx context.Context, nodeOvercommitConfig *overcommitv1alpha1.NodeOvercommitConfigApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.NodeOvercommitConfig, err error) {
+	if nodeOvercommitConfig == nil {
+		return nil, fmt.Errorf("nodeOvercommitConfig provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(nodeOvercommitConfig)
+	if err != nil {
+		return nil, err
+	}
+	name := nodeOvercommitConfig.Name
+	if name == nil {
+		return nil, fmt.Errorf("nodeOvercommitConfig.Name must be provided to Apply")
+	}
+	result = &v1alpha1.NodeOvercommitConfig{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Resource("nodeovercommitconfigs").
+		Name(*name).
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *nodeOvercommitConfigs) ApplyStatus(ctx context.Context, nodeOvercommitConfig *overcommitv1alpha1.NodeOvercommitConfigApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.NodeOvercommitConfig, err error) {
+	if nodeOvercommitConfig == nil {
+		return nil, fmt.Errorf("nodeOvercommitConfig provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(nodeOvercommitConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	name := nodeOvercommitConfig.Name
+	if name == nil {
+		return nil, fmt.Errorf("nodeOvercommitConfig.Name must be provided to Apply")
+	}
+
+	result = &v1alpha1.NodeOvercommitConfig{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Resource("nodeovercommitconfigs").
+		Name(*name).
+		SubResource("status").
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
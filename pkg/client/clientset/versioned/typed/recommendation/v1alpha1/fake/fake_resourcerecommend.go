@@ -20,8 +20,11 @@ package fake
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 
 	v1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/recommendation/v1alpha1"
+	recommendationv1alpha1 "github.com/kubewharf/katalyst-api/pkg/client/applyconfiguration/recommendation/v1alpha1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
@@ -140,3 +143,48 @@ func (c *FakeResourceRecommends) Patch(ctx context.Context, name string, pt type
 	}
 	return obj.(*v1alpha1.ResourceRecommend), err
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied resourceRecommend.
+func (c *FakeResourceRecommends) Apply(ctx context.Context, resourceRecommend *recommendationv1alpha1.ResourceRecommendApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.ResourceRecommend, err error) {
+	if resourceRecommend == nil {
+		return nil, fmt.Errorf("resourceRecommend provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(resourceRecommend)
+	if err != nil {
+		return nil, err
+	}
+	name := resourceRecommend.Name
+	if name == nil {
+		return nil, fmt.Errorf("resourceRecommend.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(resourcerecommendsResource, c.ns, *name, types.ApplyPatchType, data), &v1alpha1.ResourceRecommend{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ResourceRecommend), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeResourceRecommends) ApplyStatus(ctx context.Context, resourceRecommend *recommendationv1alpha1.ResourceRecommendApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.ResourceRecommend, err error) {
+	if resourceRecommend == nil {
+		return nil, fmt.Errorf("resourceRecommend provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(resourceRecommend)
+	if err != nil {
+		return nil, err
+	}
+	name := resourceRecommend.Name
+	if name == nil {
+		return nil, fmt.Errorf("resourceRecommend.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(resourcerecommendsResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1alpha1.ResourceRecommend{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ResourceRecommend), err
+}
@@ -20,9 +20,12 @@ package v1alpha1
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 	"time"
 
 	v1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/recommendation/v1alpha1"
+	recommendationv1alpha1 "github.com/kubewharf/katalyst-api/pkg/client/applyconfiguration/recommendation/v1alpha1"
 	scheme "github.com/kubewharf/katalyst-api/pkg/client/clientset/versioned/scheme"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
@@ -47,6 +50,8 @@ type ResourceRecommendInterface interface {
 	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.ResourceRecommendList, error)
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ResourceRecommend, err error)
+	Apply(ctx context.Context, resourceRecommend *recommendationv1alpha1.ResourceRecommendApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.ResourceRecommend, err error)
+	ApplyStatus(ctx context.Context, resourceRecommend *recommendationv1alpha1.ResourceRecommendApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.ResourceRecommend, err error)
 	ResourceRecommendExpansion
 }
 
@@ -193,3 +198,59 @@ func (c *resourceRecommends) Patch(ctx context.Context, name string, pt types.Pa
 		Into(result)
 	return
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied resourceRecommend.
+func (c *resourceRecommends) Apply(ctx context.Context, resourceRecommend *recommendationv1alpha1.ResourceRecommendApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.ResourceRecommend, err error) {
+	if resourceRecommend == nil {
+		return nil, fmt.Errorf("resourceRecommend provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(resourceRecommend)
+	if err != nil {
+		return nil, err
+	}
+	name := resourceRecommend.Name
+	if name == nil {
+		return nil, fmt.Errorf("resourceRecommend.Name must be provided to Apply")
+	}
+	result = &v1alpha1.ResourceRecommend{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("resourcerecommends").
+		Name(*name).
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *resourceRecommends) ApplyStatus(ctx context.Context, resourceRecommend *recommendationv1alpha1.ResourceRecommendApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.ResourceRecommend, err error) {
+	if resourceRecommend == nil {
+		return nil, fmt.Errorf("resourceRecommend provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(resourceRecommend)
+	if err != nil {
+		return nil, err
+	}
+
+	name := resourceRecommend.Name
+	if name == nil {
+		return nil, fmt.Errorf("resourceRecommend.Name must be provided to Apply")
+	}
+
+	result = &v1alpha1.ResourceRecommend{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("resourcerecommends").
+		Name(*name).
+		SubResource("status").
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -20,8 +20,11 @@ package fake
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 
 	v1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/tide/v1alpha1"
+	tidev1alpha1 "github.com/kubewharf/katalyst-api/pkg/client/applyconfiguration/tide/v1alpha1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
@@ -131,3 +134,46 @@ func (c *FakeTideNodePools) Patch(ctx context.Context, name string, pt types.Pat
 	}
 	return obj.(*v1alpha1.TideNodePool), err
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied tideNodePool.
+func (c *FakeTideNodePools) Apply(ctx context.Context, tideNodePool *tidev1alpha1.TideNodePoolApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.TideNodePool, err error) {
+	if tideNodePool == nil {
+		return nil, fmt.Errorf("tideNodePool provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(tideNodePool)
+	if err != nil {
+		return nil, err
+	}
+	name := tideNodePool.Name
+	if name == nil {
+		return nil, fmt.Errorf("tideNodePool.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(tidenodepoolsResource, *name, types.ApplyPatchType, data), &v1alpha1.TideNodePool{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.TideNodePool), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeTideNodePools) ApplyStatus(ctx context.Context, tideNodePool *tidev1alpha1.TideNodePoolApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.TideNodePool, err error) {
+	if tideNodePool == nil {
+		return nil, fmt.Errorf("tideNodePool provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(tideNodePool)
+	if err != nil {
+		return nil, err
+	}
+	name := tideNodePool.Name
+	if name == nil {
+		return nil, fmt.Errorf("tideNodePool.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(tidenodepoolsResource, *name, types.ApplyPatchType, data, "status"), &v1alpha1.TideNodePool{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.TideNodePool), err
+}
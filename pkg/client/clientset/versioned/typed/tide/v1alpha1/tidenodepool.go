@@ -20,9 +20,12 @@ package v1alpha1
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 	"time"
 
 	v1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/tide/v1alpha1"
+	tidev1alpha1 "github.com/kubewharf/katalyst-api/pkg/client/applyconfiguration/tide/v1alpha1"
 	scheme "github.com/kubewharf/katalyst-api/pkg/client/clientset/versioned/scheme"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
@@ -47,6 +50,8 @@ type TideNodePoolInterface interface {
 	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.TideNodePoolList, error)
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.TideNodePool, err error)
+	Apply(ctx context.Context, tideNodePool *tidev1alpha1.TideNodePoolApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.TideNodePool, err error)
+	ApplyStatus(ctx context.Context, tideNodePool *tidev1alpha1.TideNodePoolApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.TideNodePool, err error)
 	TideNodePoolExpansion
 }
 
@@ -182,3 +187,57 @@ func (c *tideNodePools) Patch(ctx context.Context, name string, pt types.PatchTy
 		Into(result)
 	return
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied tideNodePool.
+func (c *tideNodePools) Apply(ctx context.Context, tideNodePool *tidev1alpha1.TideNodePoolApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.TideNodePool, err error) {
+	if tideNodePool == nil {
+		return nil, fmt.Errorf("tideNodePool provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(tideNodePool)
+	if err != nil {
+		return nil, err
+	}
+	name := tideNodePool.Name
+	if name == nil {
+		return nil, fmt.Errorf("tideNodePool.Name must be provided to Apply")
+	}
+	result = &v1alpha1.TideNodePool{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Resource("tidenodepools").
+		Name(*name).
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *tideNodePools) ApplyStatus(ctx context.Context, tideNodePool *tidev1alpha1.TideNodePoolApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.TideNodePool, err error) {
+	if tideNodePool == nil {
+		return nil, fmt.Errorf("tideNodePool provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(tideNodePool)
+	if err != nil {
+		return nil, err
+	}
+
+	name := tideNodePool.Name
+	if name == nil {
+		return nil, fmt.Errorf("tideNodePool.Name must be provided to Apply")
+	}
+
+	result = &v1alpha1.TideNodePool{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Resource("tidenodepools").
+		Name(*name).
+		SubResource("status").
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
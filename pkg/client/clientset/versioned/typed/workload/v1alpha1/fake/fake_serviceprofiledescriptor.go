@@ -20,8 +20,11 @@ package fake
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 
 	v1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/workload/v1alpha1"
+	workloadv1alpha1 "github.com/kubewharf/katalyst-api/pkg/client/applyconfiguration/workload/v1alpha1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
@@ -140,3 +143,48 @@ func (c *FakeServiceProfileDescriptors) Patch(ctx context.Context, name string,
 	}
 	return obj.(*v1alpha1.ServiceProfileDescriptor), err
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied serviceProfileDescriptor.
+func (c *FakeServiceProfileDescriptors) Apply(ctx context.Context, serviceProfileDescriptor *workloadv1alpha1.ServiceProfileDescriptorApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.ServiceProfileDescriptor, err error) {
+	if serviceProfileDescriptor == nil {
+		return nil, fmt.Errorf("serviceProfileDescriptor provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(serviceProfileDescriptor)
+	if err != nil {
+		return nil, err
+	}
+	name := serviceProfileDescriptor.Name
+	if name == nil {
+		return nil, fmt.Errorf("serviceProfileDescriptor.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(serviceprofiledescriptorsResource, c.ns, *name, types.ApplyPatchType, data), &v1alpha1.ServiceProfileDescriptor{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ServiceProfileDescriptor), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeServiceProfileDescriptors) ApplyStatus(ctx context.Context, serviceProfileDescriptor *workloadv1alpha1.ServiceProfileDescriptorApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.ServiceProfileDescriptor, err error) {
+	if serviceProfileDescriptor == nil {
+		return nil, fmt.Errorf("serviceProfileDescriptor provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(serviceProfileDescriptor)
+	if err != nil {
+		return nil, err
+	}
+	name := serviceProfileDescriptor.Name
+	if name == nil {
+		return nil, fmt.Errorf("serviceProfileDescriptor.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(serviceprofiledescriptorsResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1alpha1.ServiceProfileDescriptor{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ServiceProfileDescriptor), err
+}
@@ -20,9 +20,12 @@ package v1alpha1
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 	"time"
 
 	v1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/workload/v1alpha1"
+	workloadv1alpha1 "github.com/kubewharf/katalyst-api/pkg/client/applyconfiguration/workload/v1alpha1"
 	scheme "github.com/kubewharf/katalyst-api/pkg/client/clientset/versioned/scheme"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
@@ -47,6 +50,8 @@ type ServiceProfileDescriptorInterface interface {
 	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.ServiceProfileDescriptorList, error)
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ServiceProfileDescriptor, err error)
+	Apply(ctx context.Context, serviceProfileDescriptor *workloadv1alpha1.ServiceProfileDescriptorApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.ServiceProfileDescriptor, err error)
+	ApplyStatus(ctx context.Context, serviceProfileDescriptor *workloadv1alpha1.ServiceProfileDescriptorApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.ServiceProfileDescriptor, err error)
 	ServiceProfileDescriptorExpansion
 }
 
@@ -193,3 +198,59 @@ func (c *serviceProfileDescriptors) Patch(ctx context.Context, name string, pt t
 		Into(result)
 	return
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied serviceProfileDescriptor.
+func (c *serviceProfileDescriptors) Apply(ctx context.Context, serviceProfileDescriptor *workloadv1alpha1.ServiceProfileDescriptorApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.ServiceProfileDescriptor, err error) {
+	if serviceProfileDescriptor == nil {
+		return nil, fmt.Errorf("serviceProfileDescriptor provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(serviceProfileDescriptor)
+	if err != nil {
+		return nil, err
+	}
+	name := serviceProfileDescriptor.Name
+	if name == nil {
+		return nil, fmt.Errorf("serviceProfileDescriptor.Name must be provided to Apply")
+	}
+	result = &v1alpha1.ServiceProfileDescriptor{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("serviceprofiledescriptors").
+		Name(*name).
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *serviceProfileDescriptors) ApplyStatus(ctx context.Context, serviceProfileDescriptor *workloadv1alpha1.ServiceProfileDescriptorApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha1.ServiceProfileDescriptor, err error) {
+	if serviceProfileDescriptor == nil {
+		return nil, fmt.Errorf("serviceProfileDescriptor provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(serviceProfileDescriptor)
+	if err != nil {
+		return nil, err
+	}
+
+	name := serviceProfileDescriptor.Name
+	if name == nil {
+		return nil, fmt.Errorf("serviceProfileDescriptor.Name must be provided to Apply")
+	}
+
+	result = &v1alpha1.ServiceProfileDescriptor{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("serviceprofiledescriptors").
+		Name(*name).
+		SubResource("status").
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
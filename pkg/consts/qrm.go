@@ -16,6 +16,8 @@ limitations under the License.
 
 package consts
 
+import "fmt"
+
 // QRMPhase is the phase of each rpc call in qrm plugin
 type QRMPhase int
 
@@ -29,3 +31,47 @@ const (
 	QRMPhaseAllocate
 	QRMPhasePreStartContainer
 )
+
+var qrmPhaseNames = map[QRMPhase]string{
+	QRMPhaseGetTopologyHints:                     "GetTopologyHints",
+	QRMPhaseRemovePod:                            "RemovePod",
+	QRMPhaseGetResourcesAllocation:               "GetResourcesAllocation",
+	QRMPhaseGetTopologyAwareResources:            "GetTopologyAwareResources",
+	QRMPhaseGetTopologyAwareAllocatableResources: "GetTopologyAwareAllocatableResources",
+	QRMPhaseGetResourcePluginOptions:             "GetResourcePluginOptions",
+	QRMPhaseAllocate:                             "Allocate",
+	QRMPhasePreStartContainer:                    "PreStartContainer",
+}
+
+// String returns the name of the rpc call corresponding to this phase
+func (p QRMPhase) String() string {
+	if name, ok := qrmPhaseNames[p]; ok {
+		return name
+	}
+	return fmt.Sprintf("QRMPhase(%d)", int(p))
+}
+
+// MarshalText encodes the phase as its name
+func (p QRMPhase) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText decodes the phase from its name
+func (p *QRMPhase) UnmarshalText(text []byte) error {
+	phase, err := ParseQRMPhase(string(text))
+	if err != nil {
+		return err
+	}
+	*p = phase
+	return nil
+}
+
+// ParseQRMPhase returns the phase with the given name
+func ParseQRMPhase(name string) (QRMPhase, error) {
+	for phase, phaseName := range qrmPhaseNames {
+		if phaseName == name {
+			return phase, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown qrm phase %q", name)
+}
@@ -18,3 +18,51 @@ limitations under the License.
 // those metrics are stored (and also can be referred) by custom metrics
 // api-server provided by katalyst.
 package external
+
+import (
+	workloadv1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/workload/v1alpha1"
+	"github.com/kubewharf/katalyst-api/pkg/metric"
+)
+
+// business-related metric
+const (
+	CustomMetricBusinessQPS            = "business_qps"
+	CustomMetricBusinessRPCLatency     = "business_rpc_latency"
+	CustomMetricBusinessRPCSuccessRate = "business_rpc_success_rate"
+)
+
+func init() {
+	DefaultRegistry.MustRegister(
+		MetricDefinition{
+			Name:        CustomMetricBusinessQPS,
+			Description: "queries per second served by the workload",
+			Unit:        metric.UnitPerSecond,
+			ValueType:   ValueTypeFloat,
+			AggregateFunctions: []string{
+				metric.AggregateFunctionAvg, metric.AggregateFunctionMax,
+				metric.AggregateFunctionMin, metric.AggregateFunctionLatest,
+			},
+		},
+		MetricDefinition{
+			Name:        CustomMetricBusinessRPCLatency,
+			Description: "latency of rpc requests served by the workload",
+			Unit:        metric.UnitMillisecond,
+			ValueType:   ValueTypeFloat,
+			AggregateFunctions: []string{
+				metric.AggregateFunctionAvg, metric.AggregateFunctionMax, metric.AggregateFunctionMin,
+				metric.AggregateFunctionP99, metric.AggregateFunctionP95, metric.AggregateFunctionP90,
+				metric.AggregateFunctionLatest,
+			},
+			BusinessIndicator: workloadv1alpha1.ServiceBusinessIndicatorNameRPCLatency,
+		},
+		MetricDefinition{
+			Name:        CustomMetricBusinessRPCSuccessRate,
+			Description: "ratio of successful rpc requests served by the workload",
+			Unit:        metric.UnitRatio,
+			ValueType:   ValueTypeFloat,
+			AggregateFunctions: []string{
+				metric.AggregateFunctionAvg, metric.AggregateFunctionMin, metric.AggregateFunctionLatest,
+			},
+		},
+	)
+}
@@ -0,0 +1,223 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	autoscalingv1alpha2 "github.com/kubewharf/katalyst-api/pkg/apis/autoscaling/v1alpha2"
+	workloadv1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/workload/v1alpha1"
+	"github.com/kubewharf/katalyst-api/pkg/metric"
+)
+
+// ValueType is the type of values of a metric
+type ValueType string
+
+const (
+	ValueTypeInteger ValueType = "integer"
+	ValueTypeFloat   ValueType = "float"
+)
+
+// MetricDefinition declares an external metric that can be referred to kcmas.
+type MetricDefinition struct {
+	Name        string
+	Description string
+	Unit        metric.Unit
+	ValueType   ValueType
+
+	// AggregateFunctions are the aggregate functions allowed for the metric, such as
+	// metric.AggregateFunctionP99, and the metric can't be aggregated if it's empty.
+	AggregateFunctions []string
+
+	// BusinessIndicator is the business indicator in spd backed by the metric, and it's
+	// empty if the metric doesn't back any.
+	BusinessIndicator workloadv1alpha1.ServiceBusinessIndicatorName
+}
+
+// Registry contains the definitions of external metrics, and it's safe for concurrent use.
+type Registry struct {
+	mutex       sync.RWMutex
+	definitions map[string]MetricDefinition
+}
+
+// NewRegistry returns an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{definitions: make(map[string]MetricDefinition)}
+}
+
+// DefaultRegistry contains the built-in external metrics, and other packages can register
+// their metrics in it during initialization.
+var DefaultRegistry = NewRegistry()
+
+// Register adds the definitions, and returns error if any of them is invalid or already registered;
+// no definition is added if error is returned.
+func (r *Registry) Register(definitions ...MetricDefinition) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	names := sets.NewString()
+	for _, definition := range definitions {
+		if err := validateMetricDefinition(&definition); err != nil {
+			return err
+		}
+
+		if _, ok := r.definitions[definition.Name]; ok || names.Has(definition.Name) {
+			return fmt.Errorf("external metric %s is already registered", definition.Name)
+		}
+		names.Insert(definition.Name)
+	}
+
+	for _, definition := range definitions {
+		definition.AggregateFunctions = append([]string(nil), definition.AggregateFunctions...)
+		r.definitions[definition.Name] = definition
+	}
+	return nil
+}
+
+// MustRegister is like Register but panics if error is returned.
+func (r *Registry) MustRegister(definitions ...MetricDefinition) {
+	if err := r.Register(definitions...); err != nil {
+		panic(err)
+	}
+}
+
+// Get returns the definition of the metric, and the name must not contain aggregate functions.
+func (r *Registry) Get(name string) (MetricDefinition, bool) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	definition, ok := r.definitions[name]
+	return definition, ok
+}
+
+// List returns all the definitions sorted by name.
+func (r *Registry) List() []MetricDefinition {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	definitions := make([]MetricDefinition, 0, len(r.definitions))
+	for _, definition := range r.definitions {
+		definitions = append(definitions, definition)
+	}
+	sort.Slice(definitions, func(i, j int) bool {
+		return definitions[i].Name < definitions[j].Name
+	})
+	return definitions
+}
+
+// ValidateMetricName validates that the metric is registered, and the aggregate function in
+// the name (if any) is allowed for it.
+func (r *Registry) ValidateMetricName(name string) error {
+	metricName, err := metric.ParseMetricName(name)
+	if err != nil {
+		return err
+	}
+
+	definition, ok := r.Get(metricName.Base)
+	if !ok {
+		return fmt.Errorf("external metric %s is not registered", metricName.Base)
+	}
+
+	if metricName.IsAggregated() {
+		function := strings.TrimPrefix(metricName.String(), metricName.Base)
+		if !sets.NewString(definition.AggregateFunctions...).Has(function) {
+			return fmt.Errorf("aggregate function %s is not allowed for external metric %s, allowed: %v",
+				function, metricName.Base, definition.AggregateFunctions)
+		}
+	}
+	return nil
+}
+
+// metricNamePattern matches queries in the form of a bare metric name
+var metricNamePattern = regexp.MustCompile(`^[a-zA-Z_:][a-zA-Z0-9_:]*$`)
+
+// ValidateQuery validates the query of an external metric. Queries are free-form statements of
+// the metrics backend, so only queries in the form of a bare metric name (e.g.
+// business_rpc_latency_agg_p99) are validated against the registry, and the others (including
+// empty ones) are left to the backend.
+func (r *Registry) ValidateQuery(query string) error {
+	query = strings.TrimSpace(query)
+	if !metricNamePattern.MatchString(query) {
+		return nil
+	}
+	return r.ValidateMetricName(query)
+}
+
+// ValidateCustomMetricSpec validates the query of the custom metric in ihpa, and the query can
+// be empty to use the preset templates.
+func (r *Registry) ValidateCustomMetricSpec(spec *autoscalingv1alpha2.CustomMetricSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if err := r.ValidateQuery(spec.Query); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("query"), spec.Query, err.Error()))
+	}
+	return allErrs
+}
+
+// ValidateServiceBusinessIndicatorSpec validates that the business indicator in spd is backed by
+// a registered external metric; in strict mode, indicators not backed are rejected, otherwise they
+// are left to out-of-tree metrics backends.
+func (r *Registry) ValidateServiceBusinessIndicatorSpec(spec *workloadv1alpha1.ServiceBusinessIndicatorSpec,
+	fldPath *field.Path, strict bool,
+) field.ErrorList {
+	var supported []string
+	for _, definition := range r.List() {
+		if definition.BusinessIndicator == "" {
+			continue
+		} else if definition.BusinessIndicator == spec.Name {
+			return nil
+		}
+		supported = append(supported, string(definition.BusinessIndicator))
+	}
+
+	if !strict {
+		return nil
+	}
+	return field.ErrorList{field.NotSupported(fldPath.Child("name"), spec.Name, supported)}
+}
+
+func validateMetricDefinition(definition *MetricDefinition) error {
+	if definition.Name == "" {
+		return fmt.Errorf("external metric name is empty")
+	}
+
+	metricName, err := metric.ParseMetricName(definition.Name)
+	if err != nil {
+		return err
+	} else if metricName.IsAggregated() {
+		return fmt.Errorf("external metric name %s must not contain aggregate function", definition.Name)
+	}
+
+	switch definition.ValueType {
+	case ValueTypeInteger, ValueTypeFloat:
+	default:
+		return fmt.Errorf("external metric %s has unsupported value type %q", definition.Name, definition.ValueType)
+	}
+
+	for _, function := range definition.AggregateFunctions {
+		if parsed, err := metric.ParseMetricName(definition.Name + function); err != nil || parsed.Base != definition.Name {
+			return fmt.Errorf("external metric %s has invalid aggregate function %s", definition.Name, function)
+		}
+	}
+	return nil
+}
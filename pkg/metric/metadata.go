@@ -0,0 +1,136 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metric
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/kubewharf/katalyst-api/pkg/metric/node"
+	"github.com/kubewharf/katalyst-api/pkg/metric/pod"
+)
+
+// MetricScope is the kind of objects a metric describes
+type MetricScope string
+
+const (
+	MetricScopeNode MetricScope = "node"
+	MetricScopeNUMA MetricScope = "numa"
+	MetricScopePod  MetricScope = "pod"
+)
+
+// MetricMetadata describes how values of a built-in metric should be interpreted.
+type MetricMetadata struct {
+	Name  string
+	Unit  Unit
+	Scope MetricScope
+	// Format is the format of resource.Quantity for values of the metric in npd and spd
+	Format resource.Format
+}
+
+var metricMetadata = map[string]MetricMetadata{}
+
+func init() {
+	// units are those of the values reported by the metric emitter plugin of katalyst-core
+	// sysadvisor, which are all gauges sampled from the node, numa and pod.
+	for _, metadata := range []MetricMetadata{
+		{Name: node.CustomMetricNodeCPUTotal, Unit: UnitCores, Scope: MetricScopeNode},
+		{Name: node.CustomMetricNodeCPUUsage, Unit: UnitCores, Scope: MetricScopeNode},
+		{Name: node.CustomMetricNodeCPUUsageRatio, Unit: UnitRatio, Scope: MetricScopeNode},
+		{Name: node.CustomMetricNodeCPULoad1Min, Unit: UnitNone, Scope: MetricScopeNode},
+		{Name: node.CustomMetricNodeMemoryTotal, Unit: UnitBytes, Scope: MetricScopeNode},
+		{Name: node.CustomMetricNodeMemoryFree, Unit: UnitBytes, Scope: MetricScopeNode},
+		{Name: node.CustomMetricNodeMemoryAvailable, Unit: UnitBytes, Scope: MetricScopeNode},
+		{Name: node.CustomMetricNodeAdvisorPoolLoad1Min, Unit: UnitNone, Scope: MetricScopeNode},
+		{Name: node.CustomMetricNodeAdvisorKnobStatus, Unit: UnitNone, Scope: MetricScopeNode},
+		{Name: node.CustomMetricNUMAMemoryBandwidthTotal, Unit: UnitBytesPerSecond, Scope: MetricScopeNUMA},
+		{Name: node.CustomMetricNUMAMemoryBandwidthLocal, Unit: UnitBytesPerSecond, Scope: MetricScopeNUMA},
+		{Name: node.CustomMetricNUMAMemoryBandwidthVictim, Unit: UnitBytesPerSecond, Scope: MetricScopeNUMA},
+		{Name: node.CustomMetricNUMACPUUsage, Unit: UnitCores, Scope: MetricScopeNUMA},
+		{Name: node.CustomMetricNUMAMemoryUsage, Unit: UnitBytes, Scope: MetricScopeNUMA},
+
+		{Name: pod.CustomMetricPodCPULoad1Min, Unit: UnitNone, Scope: MetricScopePod},
+		{Name: pod.CustomMetricPodCPUUsage, Unit: UnitCores, Scope: MetricScopePod},
+		{Name: pod.CustomMetricPodCPUUsageRatio, Unit: UnitRatio, Scope: MetricScopePod},
+		{Name: pod.CustomMetricPodCPUCPI, Unit: UnitNone, Scope: MetricScopePod},
+		{Name: pod.CustomMetricPodMemoryRSS, Unit: UnitBytes, Scope: MetricScopePod},
+		{Name: pod.CustomMetricPodMemoryUsage, Unit: UnitBytes, Scope: MetricScopePod},
+		{Name: pod.CustomMetricPodGPUUsage, Unit: UnitNone, Scope: MetricScopePod},
+		{Name: pod.CustomMetricPodTotalMemoryBandwidth, Unit: UnitBytesPerSecond, Scope: MetricScopePod},
+		{Name: pod.CustomMetricPodLocalMemoryBandwidth, Unit: UnitBytesPerSecond, Scope: MetricScopePod},
+		{Name: pod.CustomMetricPodVictimMemoryBandwidth, Unit: UnitBytesPerSecond, Scope: MetricScopePod},
+	} {
+		metadata.Format = resource.DecimalSI
+		if metadata.Unit.isIntegral() {
+			metadata.Format = resource.BinarySI
+		}
+		metricMetadata[metadata.Name] = metadata
+	}
+}
+
+// GetMetricMetadata returns the metadata of the built-in metric, and aggregated metric
+// names share the metadata of their base names.
+func GetMetricMetadata(name string) (MetricMetadata, bool) {
+	metricName, err := ParseMetricName(name)
+	if err != nil {
+		return MetricMetadata{}, false
+	}
+
+	metadata, ok := metricMetadata[metricName.Base]
+	return metadata, ok
+}
+
+// ListMetricMetadata returns the metadata of all the built-in metrics sorted by name.
+func ListMetricMetadata() []MetricMetadata {
+	result := make([]MetricMetadata, 0, len(metricMetadata))
+	for _, metadata := range metricMetadata {
+		result = append(result, metadata)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+// NewMetricQuantity converts the raw value in the unit of the built-in metric to a quantity, which
+// is rounded to integer for bytes and to milli for the others, e.g. 1.5 of pod_cpu_usage is 1500m.
+func NewMetricQuantity(name string, value float64) (resource.Quantity, error) {
+	metadata, ok := GetMetricMetadata(name)
+	if !ok {
+		return resource.Quantity{}, fmt.Errorf("metric %s is not a built-in metric", name)
+	}
+
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return resource.Quantity{}, fmt.Errorf("metric %s has invalid value %v", name, value)
+	}
+
+	if metadata.Unit.isIntegral() {
+		return *resource.NewQuantity(int64(math.Round(value)), metadata.Format), nil
+	}
+	return *resource.NewMilliQuantity(int64(math.Round(value*1000)), metadata.Format), nil
+}
+
+// MetricQuantityValue converts the quantity of the built-in metric back to the raw value in its unit.
+func MetricQuantityValue(name string, quantity resource.Quantity) (float64, error) {
+	if _, ok := GetMetricMetadata(name); !ok {
+		return 0, fmt.Errorf("metric %s is not a built-in metric", name)
+	}
+	return quantity.AsApproximateFloat64(), nil
+}
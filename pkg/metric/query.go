@@ -0,0 +1,238 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metric
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// aggregateFunctionPrefix is the common prefix of all the aggregate functions
+const aggregateFunctionPrefix = "_agg_"
+
+var aggregateFunctions = sets.NewString(
+	AggregateFunctionAvg,
+	AggregateFunctionMax,
+	AggregateFunctionMin,
+	AggregateFunctionP99,
+	AggregateFunctionP95,
+	AggregateFunctionP90,
+	AggregateFunctionLatest,
+)
+
+// spdSelectorKeys are the metric selector keys parsed into SPDMetricQuery
+var spdSelectorKeys = sets.NewString(
+	MetricSelectorKeySPDName,
+	MetricSelectorKeySPDResourceName,
+	MetricSelectorKeySPDScopeName,
+	MetricSelectorKeySPDContainerName,
+)
+
+// MetricName is the structured form of a metric name referred to kcmas,
+// e.g. `pod_cpu_usage_agg_p99` is {Base: pod_cpu_usage, Aggregate: p99}.
+type MetricName struct {
+	Base string
+	// Aggregate is the aggregate function without the prefix `_agg_`,
+	// and it's empty if the metric is not aggregated.
+	Aggregate string
+}
+
+// ParseMetricName splits the aggregate function from the metric name. Only the supported aggregate
+// functions are split as suffixes, so names merely containing `_agg_` (e.g. spd_agg_metrics) are
+// kept as base names as a whole.
+func ParseMetricName(name string) (MetricName, error) {
+	for _, function := range aggregateFunctions.List() {
+		if strings.HasSuffix(name, function) {
+			base := strings.TrimSuffix(name, function)
+			return MetricName{Base: base, Aggregate: strings.TrimPrefix(function, aggregateFunctionPrefix)}, validateMetricBaseName(base)
+		}
+	}
+	return MetricName{Base: name}, validateMetricBaseName(name)
+}
+
+// IsAggregated returns whether the metric is aggregated
+func (n MetricName) IsAggregated() bool {
+	return n.Aggregate != ""
+}
+
+// String formats the metric name referred to kcmas
+func (n MetricName) String() string {
+	if n.Aggregate == "" {
+		return n.Base
+	}
+	return n.Base + aggregateFunctionPrefix + n.Aggregate
+}
+
+func validateMetricBaseName(base string) error {
+	if base == "" {
+		return fmt.Errorf("metric name is empty")
+	}
+	return nil
+}
+
+// SPDMetricQuery locates the metrics of a workload in its spd, and empty fields match all.
+type SPDMetricQuery struct {
+	Name          string
+	ResourceName  string
+	ScopeName     string
+	ContainerName string
+}
+
+// MetricSelector is the structured form of a metric selector referred to kcmas.
+type MetricSelector struct {
+	// GroupBy are the sorted selector keys to group the aggregated metrics by.
+	GroupBy []string
+	// SPD is parsed from the spd selector keys, and it's nil if none is specified.
+	SPD *SPDMetricQuery
+	// Selector contains the rest of the requirements to filter metrics by labels.
+	Selector labels.Selector
+}
+
+// ParseMetricSelector parses groupBy and spd keys out of the selector. Both of them only support
+// equality requirements, while groupBy also supports `in` for multiple keys.
+func ParseMetricSelector(selector labels.Selector) (*MetricSelector, error) {
+	result := &MetricSelector{Selector: labels.NewSelector()}
+	if selector == nil {
+		return result, nil
+	}
+
+	requirements, _ := selector.Requirements()
+	for _, requirement := range requirements {
+		key := requirement.Key()
+		switch {
+		case key == MetricSelectorKeyGroupBy:
+			if requirement.Operator() != selection.In && !isEqualityOperator(requirement.Operator()) {
+				return nil, fmt.Errorf("unsupported operator %s for selector key %s", requirement.Operator(), key)
+			}
+			result.GroupBy = sets.NewString(result.GroupBy...).Insert(requirement.Values().List()...).List()
+		case spdSelectorKeys.Has(key):
+			values := requirement.Values()
+			if !isEqualityOperator(requirement.Operator()) || values.Len() != 1 {
+				return nil, fmt.Errorf("selector key %s only supports a single value with equality operator", key)
+			}
+			if result.SPD == nil {
+				result.SPD = &SPDMetricQuery{}
+			}
+			setSPDMetricQueryField(result.SPD, key, values.List()[0])
+		default:
+			result.Selector = result.Selector.Add(requirement)
+		}
+	}
+
+	if result.SPD != nil && result.SPD.Name == "" {
+		return nil, fmt.Errorf("selector key %s is required with other spd selector keys", MetricSelectorKeySPDName)
+	}
+	return result, nil
+}
+
+// LabelSelector formats the metric selector back to a label selector.
+func (s *MetricSelector) LabelSelector() (labels.Selector, error) {
+	selector := labels.NewSelector()
+	if s.Selector != nil {
+		requirements, _ := s.Selector.Requirements()
+		selector = selector.Add(requirements...)
+	}
+
+	if len(s.GroupBy) > 0 {
+		operator := selection.Equals
+		if len(s.GroupBy) > 1 {
+			operator = selection.In
+		}
+		requirement, err := labels.NewRequirement(MetricSelectorKeyGroupBy, operator, s.GroupBy)
+		if err != nil {
+			return nil, err
+		}
+		selector = selector.Add(*requirement)
+	}
+
+	if s.SPD != nil {
+		for _, key := range spdSelectorKeys.List() {
+			value := spdMetricQueryField(s.SPD, key)
+			if value == "" {
+				continue
+			}
+
+			requirement, err := labels.NewRequirement(key, selection.Equals, []string{value})
+			if err != nil {
+				return nil, err
+			}
+			selector = selector.Add(*requirement)
+		}
+	}
+	return selector, nil
+}
+
+// MetricQuery is the structured form of a metric name and its selector referred to kcmas.
+type MetricQuery struct {
+	Name     MetricName
+	Selector *MetricSelector
+}
+
+// ParseMetricQuery parses the metric name and selector, and groupBy is only allowed for
+// aggregated metrics.
+func ParseMetricQuery(name string, selector labels.Selector) (*MetricQuery, error) {
+	metricName, err := ParseMetricName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	metricSelector, err := ParseMetricSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(metricSelector.GroupBy) > 0 && !metricName.IsAggregated() {
+		return nil, fmt.Errorf("selector key %s is only supported for aggregated metrics, but got %s",
+			MetricSelectorKeyGroupBy, name)
+	}
+	return &MetricQuery{Name: metricName, Selector: metricSelector}, nil
+}
+
+func isEqualityOperator(operator selection.Operator) bool {
+	return operator == selection.Equals || operator == selection.DoubleEquals
+}
+
+func setSPDMetricQueryField(query *SPDMetricQuery, key, value string) {
+	switch key {
+	case MetricSelectorKeySPDName:
+		query.Name = value
+	case MetricSelectorKeySPDResourceName:
+		query.ResourceName = value
+	case MetricSelectorKeySPDScopeName:
+		query.ScopeName = value
+	case MetricSelectorKeySPDContainerName:
+		query.ContainerName = value
+	}
+}
+
+func spdMetricQueryField(query *SPDMetricQuery, key string) string {
+	switch key {
+	case MetricSelectorKeySPDName:
+		return query.Name
+	case MetricSelectorKeySPDResourceName:
+		return query.ResourceName
+	case MetricSelectorKeySPDScopeName:
+		return query.ScopeName
+	case MetricSelectorKeySPDContainerName:
+		return query.ContainerName
+	}
+	return ""
+}
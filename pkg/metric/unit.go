@@ -0,0 +1,43 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metric
+
+// Unit is the unit of values of a metric
+type Unit string
+
+const (
+	// UnitNone is for dimensionless values, such as counts and scores
+	UnitNone Unit = ""
+	// UnitRatio is for ratios in [0, 1]
+	UnitRatio Unit = "ratio"
+
+	// UnitCores is for cpu in cores, which may be fractional
+	UnitCores Unit = "cores"
+
+	UnitBytes          Unit = "bytes"
+	UnitBytesPerSecond Unit = "bytes/s"
+
+	UnitMillisecond Unit = "ms"
+
+	// UnitPerSecond is for rates, such as qps
+	UnitPerSecond Unit = "1/s"
+)
+
+// isIntegral returns whether values in the unit are meaningful only as integers
+func (u Unit) isIntegral() bool {
+	return u == UnitBytes || u == UnitBytesPerSecond
+}
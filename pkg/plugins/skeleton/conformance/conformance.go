@@ -0,0 +1,304 @@
+//go:build !windows
+// +build !windows
+
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package conformance provides a suite to check whether a katalyst plugin follows
+// the protocol expected by katalyst-agent; plugin authors can run it in their own
+// unit tests, and the plugin will be served on a real unix socket during the test.
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials/insecure"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	watcherapi "k8s.io/kubelet/pkg/apis/pluginregistration/v1"
+
+	"github.com/kubewharf/katalyst-api/pkg/plugins/registration"
+	"github.com/kubewharf/katalyst-api/pkg/plugins/skeleton"
+)
+
+const (
+	defaultTimeout = 10 * time.Second
+
+	pollInterval = 100 * time.Millisecond
+)
+
+// GenericOptions are the options shared by all kinds of plugin suites.
+type GenericOptions struct {
+	// SocketDir is the directory to put plugin sockets in, and a temporary
+	// directory will be created and removed after test if it's empty.
+	SocketDir string
+
+	// Timeout is the max waiting time for each check, defaults to 10s.
+	Timeout time.Duration
+}
+
+func (o *GenericOptions) complete() {
+	if o.Timeout <= 0 {
+		o.Timeout = defaultTimeout
+	}
+}
+
+// pluginServer wraps the plugin to be tested with PluginRegistrationWrapper,
+// and serves it at the socket in the given directory.
+type pluginServer struct {
+	plugin  skeleton.GenericPlugin
+	wrapper *skeleton.PluginRegistrationWrapper
+	socket  string
+	timeout time.Duration
+	stopped bool
+}
+
+func startPluginServer(t *testing.T, plugin skeleton.GenericPlugin, opts GenericOptions) *pluginServer {
+	t.Helper()
+
+	socketDir := opts.SocketDir
+	if socketDir == "" {
+		// unix socket path is limited to 108 characters, so we don't use t.TempDir
+		// which may be too long for those tests with long names.
+		dir, err := os.MkdirTemp("", "katalyst-conformance")
+		if err != nil {
+			t.Fatalf("create socket dir failed: %v", err)
+		}
+		t.Cleanup(func() { _ = os.RemoveAll(dir) })
+		socketDir = dir
+	}
+
+	wrapper, err := skeleton.NewRegistrationPluginWrapper(plugin, []string{socketDir}, nil)
+	if err != nil {
+		t.Fatalf("wrap plugin %s failed: %v", plugin.Name(), err)
+	}
+
+	s := &pluginServer{
+		plugin:  plugin,
+		wrapper: wrapper,
+		socket:  path.Join(socketDir, fmt.Sprintf("%s.sock", plugin.Name())),
+		timeout: opts.Timeout,
+	}
+
+	if err := wrapper.Start(); err != nil {
+		t.Fatalf("start plugin %s failed: %v", plugin.Name(), err)
+	}
+
+	// make sure the wrapper is stopped if the suite exits without running checkStop
+	t.Cleanup(func() {
+		if !s.stopped {
+			_ = wrapper.Stop()
+		}
+	})
+
+	if err := s.waitForServing(); err != nil {
+		t.Fatalf("plugin %s is not serving: %v", plugin.Name(), err)
+	}
+
+	return s
+}
+
+// dial connects to the plugin socket and blocks until the connection is ready.
+func (s *pluginServer) dial() (*grpc.ClientConn, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+
+	return grpc.DialContext(ctx, s.socket,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithBlock(),
+		grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, "unix", addr)
+		}),
+	)
+}
+
+// waitForServing waits until GetInfo can be called successfully through the plugin socket.
+func (s *pluginServer) waitForServing() error {
+	return wait.PollImmediate(pollInterval, s.timeout, func() (bool, error) {
+		if _, err := os.Stat(s.socket); err != nil {
+			return false, nil
+		}
+
+		conn, err := s.dial()
+		if err != nil {
+			return false, nil
+		}
+		defer func() { _ = conn.Close() }()
+
+		ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+		defer cancel()
+
+		_, err = watcherapi.NewRegistrationClient(conn).GetInfo(ctx, &watcherapi.InfoRequest{})
+		return err == nil, nil
+	})
+}
+
+// checkRegistration checks the registration handshake with the kubelet-style plugin watcher.
+func (s *pluginServer) checkRegistration(t *testing.T, pluginType, pluginName, version string) {
+	t.Helper()
+
+	conn, err := s.dial()
+	if err != nil {
+		t.Fatalf("dial plugin socket %s failed: %v", s.socket, err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+
+	client := watcherapi.NewRegistrationClient(conn)
+	info, err := client.GetInfo(ctx, &watcherapi.InfoRequest{})
+	if err != nil {
+		t.Fatalf("GetInfo failed: %v", err)
+	}
+
+	if info.Type != pluginType {
+		t.Errorf("GetInfo returns plugin type %q, expected %q", info.Type, pluginType)
+	}
+	if info.Name != pluginName {
+		t.Errorf("GetInfo returns plugin name %q, expected %q", info.Name, pluginName)
+	}
+	if !sets.NewString(info.SupportedVersions...).Has(version) {
+		t.Errorf("GetInfo returns supported versions %v, expected to include %q", info.SupportedVersions, version)
+	}
+
+	for _, registered := range []bool{true, false} {
+		if _, err := client.NotifyRegistrationStatus(ctx, &watcherapi.RegistrationStatus{
+			PluginRegistered: registered,
+			Error:            fmt.Sprintf("registered: %v", registered),
+		}); err != nil {
+			t.Errorf("NotifyRegistrationStatus with registered %v failed: %v", registered, err)
+		}
+	}
+}
+
+// checkRestart triggers the wrapper to restart, and checks that the opened stream
+// is closed and the plugin is serving again after restart.
+func (s *pluginServer) checkRestart(t *testing.T, openStream func(ctx context.Context, conn *grpc.ClientConn) (func() error, error)) {
+	t.Helper()
+
+	conn, err := s.dial()
+	if err != nil {
+		t.Fatalf("dial plugin socket %s failed: %v", s.socket, err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var recv func() error
+	if openStream != nil {
+		recv, err = openStream(ctx, conn)
+		if err != nil {
+			t.Fatalf("open stream before restart failed: %v", err)
+		}
+	}
+
+	if err := s.wrapper.Restart(); err != nil {
+		t.Fatalf("restart plugin failed: %v", err)
+	}
+
+	// the connection established before restart must be broken when the old server stops
+	stateCtx, stateCancel := context.WithTimeout(context.Background(), s.timeout)
+	defer stateCancel()
+	if !conn.WaitForStateChange(stateCtx, connectivity.Ready) {
+		t.Fatalf("connection is still ready in %v after restart", s.timeout)
+	}
+
+	if recv != nil {
+		closed := make(chan struct{})
+		go func() {
+			defer close(closed)
+			for recv() == nil {
+			}
+		}()
+
+		select {
+		case <-closed:
+		case <-time.After(s.timeout):
+			t.Errorf("stream opened before restart is not closed in %v", s.timeout)
+		}
+	}
+
+	if err := s.waitForServing(); err != nil {
+		t.Fatalf("plugin is not serving after restart: %v", err)
+	}
+
+	if openStream != nil {
+		newConn, err := s.dial()
+		if err != nil {
+			t.Fatalf("dial plugin socket %s after restart failed: %v", s.socket, err)
+		}
+		defer func() { _ = newConn.Close() }()
+
+		streamCtx, streamCancel := context.WithTimeout(context.Background(), s.timeout)
+		defer streamCancel()
+
+		if _, err := openStream(streamCtx, newConn); err != nil {
+			t.Errorf("open stream after restart failed: %v", err)
+		}
+	}
+}
+
+// checkStop stops the wrapper, and checks that the socket is cleaned up, the wrapper
+// can't be restarted any more and the plugin itself can be stopped and restarted reentrantly.
+func (s *pluginServer) checkStop(t *testing.T) {
+	t.Helper()
+
+	s.stopped = true
+	if err := s.wrapper.Stop(); err != nil {
+		t.Fatalf("stop plugin failed: %v", err)
+	}
+
+	if _, err := os.Stat(s.socket); !os.IsNotExist(err) {
+		t.Errorf("socket %s still exists after stop: %v", s.socket, err)
+	}
+
+	if err := s.wrapper.Restart(); err == nil {
+		t.Errorf("restart plugin after stop is expected to fail")
+	}
+
+	if err := s.plugin.Stop(); err != nil {
+		t.Errorf("stop a stopped plugin failed: %v", err)
+	}
+
+	if err := s.plugin.Start(); err != nil {
+		t.Errorf("start plugin again after stop failed: %v", err)
+	}
+
+	if err := s.plugin.Stop(); err != nil {
+		t.Errorf("stop plugin again after start failed: %v", err)
+	}
+}
+
+// registrationTypes returns the expected registration type and version for plugins.
+func registrationTypes(plugin skeleton.GenericPlugin) (string, string) {
+	switch plugin.(type) {
+	case skeleton.EvictionPlugin:
+		return registration.EvictionPlugin, registration.BaseVersion
+	case skeleton.ReporterPlugin:
+		return registration.ReporterPlugin, registration.BaseVersion
+	}
+	return "", ""
+}
@@ -0,0 +1,97 @@
+//go:build !windows
+// +build !windows
+
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conformance
+
+import (
+	"context"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/kubewharf/katalyst-api/pkg/plugins/skeleton"
+	"github.com/kubewharf/katalyst-api/pkg/protocol/evictionplugin/v1alpha1"
+)
+
+// EvictionPluginOptions are the options to run eviction plugin suite.
+type EvictionPluginOptions struct {
+	GenericOptions
+
+	// ActivePods are passed to the plugin in each request.
+	ActivePods []*v1.Pod
+}
+
+// RunEvictionPluginSuite checks that the eviction plugin works as expected by katalyst-agent,
+// including registration, all eviction rpc, restart and stop.
+func RunEvictionPluginSuite(t *testing.T, plugin skeleton.EvictionPlugin, opts EvictionPluginOptions) {
+	opts.complete()
+
+	s := startPluginServer(t, plugin, opts.GenericOptions)
+
+	t.Run("Registration", func(t *testing.T) {
+		pluginType, version := registrationTypes(plugin)
+		s.checkRegistration(t, pluginType, plugin.Name(), version)
+	})
+
+	t.Run("EvictionRPC", func(t *testing.T) {
+		conn, err := s.dial()
+		if err != nil {
+			t.Fatalf("dial plugin socket %s failed: %v", s.socket, err)
+		}
+		defer func() { _ = conn.Close() }()
+
+		ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+		defer cancel()
+
+		client := v1alpha1.NewEvictionPluginClient(conn)
+		if _, err := client.GetToken(ctx, &v1alpha1.Empty{}); err != nil {
+			t.Errorf("GetToken failed: %v", err)
+		}
+
+		if _, err := client.ThresholdMet(ctx, &v1alpha1.GetThresholdMetRequest{
+			ActivePods: opts.ActivePods,
+		}); err != nil {
+			t.Errorf("ThresholdMet failed: %v", err)
+		}
+
+		resp, err := client.GetTopEvictionPods(ctx, &v1alpha1.GetTopEvictionPodsRequest{
+			ActivePods: opts.ActivePods,
+			TopN:       1,
+		})
+		if err != nil {
+			t.Errorf("GetTopEvictionPods failed: %v", err)
+		} else if len(resp.TargetPods) > 1 {
+			t.Errorf("GetTopEvictionPods returns %d pods, more than topN 1", len(resp.TargetPods))
+		}
+
+		if _, err := client.GetEvictPods(ctx, &v1alpha1.GetEvictPodsRequest{
+			ActivePods: opts.ActivePods,
+		}); err != nil {
+			t.Errorf("GetEvictPods failed: %v", err)
+		}
+	})
+
+	t.Run("Restart", func(t *testing.T) {
+		s.checkRestart(t, nil)
+	})
+
+	t.Run("Stop", func(t *testing.T) {
+		s.checkStop(t)
+	})
+}
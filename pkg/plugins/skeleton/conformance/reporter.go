@@ -0,0 +1,288 @@
+//go:build !windows
+// +build !windows
+
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conformance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/kubewharf/katalyst-api/pkg/plugins/skeleton"
+	"github.com/kubewharf/katalyst-api/pkg/protocol/reporterplugin/v1alpha1"
+	"github.com/kubewharf/katalyst-api/pkg/utils"
+)
+
+// ReporterPluginUpdater is implemented by reporter plugins which can change their report
+// content on demand, such as skeleton.ReporterPluginBase.
+type ReporterPluginUpdater interface {
+	Update(content []*v1alpha1.ReportContent)
+}
+
+// ReporterPluginOptions are the options to run reporter plugin suite.
+type ReporterPluginOptions struct {
+	GenericOptions
+
+	// Updates are pushed into the plugin one by one to check the streaming behaviors,
+	// each of them should be different from the previous one and the initial content.
+	Updates [][]*v1alpha1.ReportContent
+
+	// UpdateFunc is used to push Updates into the plugin, and defaults to the Update
+	// function if the plugin implements ReporterPluginUpdater; streaming checks with
+	// Updates are skipped if it's nil.
+	UpdateFunc func(content []*v1alpha1.ReportContent)
+
+	// Validator is used to validate report contents, and defaults to the one
+	// returned by utils.NewDefaultReportContentValidator.
+	Validator *utils.ReportContentValidator
+}
+
+func (o *ReporterPluginOptions) complete(plugin skeleton.ReporterPlugin) {
+	o.GenericOptions.complete()
+
+	if o.UpdateFunc == nil {
+		if updater, ok := plugin.(ReporterPluginUpdater); ok {
+			o.UpdateFunc = updater.Update
+		}
+	}
+
+	if o.Validator == nil {
+		o.Validator = utils.NewDefaultReportContentValidator()
+	}
+}
+
+// RunReporterPluginSuite checks that the reporter plugin works as expected by katalyst-agent,
+// including registration, get and list-watch report content, restart and stop.
+func RunReporterPluginSuite(t *testing.T, plugin skeleton.ReporterPlugin, opts ReporterPluginOptions) {
+	opts.complete(plugin)
+
+	s := startPluginServer(t, plugin, opts.GenericOptions)
+
+	t.Run("Registration", func(t *testing.T) {
+		pluginType, version := registrationTypes(plugin)
+		s.checkRegistration(t, pluginType, plugin.Name(), version)
+	})
+
+	t.Run("GetReportContent", func(t *testing.T) {
+		withReporterClient(t, s, func(ctx context.Context, client v1alpha1.ReporterPluginClient) {
+			resp, err := client.GetReportContent(ctx, &v1alpha1.Empty{})
+			if err != nil {
+				t.Fatalf("GetReportContent failed: %v", err)
+			}
+
+			if err := opts.Validator.Validate(resp.Content); err != nil {
+				t.Errorf("GetReportContent returns invalid content: %v", err)
+			}
+		})
+	})
+
+	t.Run("ListAndWatchReportContent", func(t *testing.T) {
+		withReporterClient(t, s, func(ctx context.Context, client v1alpha1.ReporterPluginClient) {
+			checkListAndWatchReportContent(ctx, t, client, opts)
+		})
+	})
+
+	t.Run("ListAndWatchVersionedReportContent", func(t *testing.T) {
+		withReporterClient(t, s, func(ctx context.Context, client v1alpha1.ReporterPluginClient) {
+			checkListAndWatchVersionedReportContent(ctx, t, client, opts)
+		})
+	})
+
+	t.Run("Restart", func(t *testing.T) {
+		s.checkRestart(t, func(ctx context.Context, conn *grpc.ClientConn) (func() error, error) {
+			stream, err := v1alpha1.NewReporterPluginClient(conn).ListAndWatchReportContent(ctx, &v1alpha1.Empty{})
+			if err != nil {
+				return nil, err
+			}
+
+			if _, err := stream.Recv(); err != nil {
+				return nil, err
+			}
+
+			return func() error {
+				_, err := stream.Recv()
+				return err
+			}, nil
+		})
+	})
+
+	t.Run("Stop", func(t *testing.T) {
+		s.checkStop(t)
+	})
+}
+
+func withReporterClient(t *testing.T, s *pluginServer, f func(ctx context.Context, client v1alpha1.ReporterPluginClient)) {
+	t.Helper()
+
+	conn, err := s.dial()
+	if err != nil {
+		t.Fatalf("dial plugin socket %s failed: %v", s.socket, err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	f(ctx, v1alpha1.NewReporterPluginClient(conn))
+}
+
+func checkListAndWatchReportContent(ctx context.Context, t *testing.T,
+	client v1alpha1.ReporterPluginClient, opts ReporterPluginOptions) {
+	ctx, cancel := context.WithTimeout(ctx, opts.Timeout*time.Duration(len(opts.Updates)+1))
+	defer cancel()
+
+	stream, err := client.ListAndWatchReportContent(ctx, &v1alpha1.Empty{})
+	if err != nil {
+		t.Fatalf("ListAndWatchReportContent failed: %v", err)
+	}
+
+	resp, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("receive initial report content failed: %v", err)
+	}
+
+	if err := opts.Validator.Validate(resp.Content); err != nil {
+		t.Errorf("ListAndWatchReportContent returns invalid initial content: %v", err)
+	}
+
+	if opts.UpdateFunc == nil {
+		return
+	}
+
+	for i, update := range opts.Updates {
+		updated := pushUpdate(opts.UpdateFunc, update)
+
+		// the plugin may send stale or duplicated contents before the updated one
+		for {
+			resp, err = stream.Recv()
+			if err != nil {
+				t.Fatalf("receive report content of update %d failed: %v", i, err)
+			}
+
+			if err := opts.Validator.Validate(resp.Content); err != nil {
+				t.Errorf("ListAndWatchReportContent returns invalid content: %v", err)
+			}
+
+			if reportContentsEqual(resp.Content, update) {
+				break
+			}
+		}
+
+		waitForUpdate(t, updated, opts.Timeout, i)
+	}
+}
+
+func checkListAndWatchVersionedReportContent(ctx context.Context, t *testing.T,
+	client v1alpha1.ReporterPluginClient, opts ReporterPluginOptions) {
+	ctx, cancel := context.WithTimeout(ctx, opts.Timeout*time.Duration(len(opts.Updates)+1))
+	defer cancel()
+
+	// the versioned stream is optional, and plugins embedding UnimplementedReporterPluginServer
+	// without implementing it are skipped
+	stream, err := client.ListAndWatchVersionedReportContent(ctx, &v1alpha1.Empty{})
+	if status.Code(err) == codes.Unimplemented {
+		t.Skipf("ListAndWatchVersionedReportContent is not implemented: %v", err)
+	} else if err != nil {
+		t.Fatalf("ListAndWatchVersionedReportContent failed: %v", err)
+	}
+
+	resp, err := stream.Recv()
+	if status.Code(err) == codes.Unimplemented {
+		t.Skipf("ListAndWatchVersionedReportContent is not implemented: %v", err)
+	} else if err != nil {
+		t.Fatalf("receive initial versioned report content failed: %v", err)
+	}
+
+	if resp.Type != v1alpha1.ResponseType_Snapshot {
+		t.Fatalf("initial versioned report content is %v, expected %v", resp.Type, v1alpha1.ResponseType_Snapshot)
+	}
+
+	current := resp.Content
+	version := resp.ResourceVersion
+	if err := opts.Validator.Validate(current); err != nil {
+		t.Errorf("ListAndWatchVersionedReportContent returns invalid initial content: %v", err)
+	}
+
+	if opts.UpdateFunc == nil {
+		return
+	}
+
+	for i, update := range opts.Updates {
+		updated := pushUpdate(opts.UpdateFunc, update)
+
+		// no-op update may not trigger any response, and the plugin may also
+		// send multiple responses before the updated one
+		for !reportContentsEqual(current, update) {
+			resp, err = stream.Recv()
+			if err != nil {
+				t.Fatalf("receive versioned report content of update %d failed: %v", i, err)
+			}
+
+			if resp.ResourceVersion <= version {
+				t.Errorf("resource version %d is not larger than previous %d", resp.ResourceVersion, version)
+			}
+			version = resp.ResourceVersion
+
+			switch resp.Type {
+			case v1alpha1.ResponseType_Snapshot:
+				current = resp.Content
+			case v1alpha1.ResponseType_Delta:
+				current, err = skeleton.ApplyReportContentDelta(current, resp.Delta)
+				if err != nil {
+					t.Fatalf("apply delta of version %d failed: %v", version, err)
+				}
+			default:
+				t.Fatalf("unknown response type %v", resp.Type)
+			}
+
+			if err := opts.Validator.Validate(current); err != nil {
+				t.Errorf("ListAndWatchVersionedReportContent returns invalid content: %v", err)
+			}
+		}
+
+		waitForUpdate(t, updated, opts.Timeout, i)
+	}
+}
+
+// pushUpdate pushes the content in a separate goroutine, since the plugin may block
+// the update until it has been consumed by the stream.
+func pushUpdate(updateFunc func(content []*v1alpha1.ReportContent), content []*v1alpha1.ReportContent) <-chan struct{} {
+	updated := make(chan struct{})
+	go func() {
+		defer close(updated)
+		updateFunc(content)
+	}()
+	return updated
+}
+
+func waitForUpdate(t *testing.T, updated <-chan struct{}, timeout time.Duration, index int) {
+	select {
+	case <-updated:
+	case <-time.After(timeout):
+		t.Errorf("update %d is not returned in %v", index, timeout)
+	}
+}
+
+func reportContentsEqual(a, b []*v1alpha1.ReportContent) bool {
+	return len(skeleton.GenerateReportContentDelta(a, b)) == 0
+}
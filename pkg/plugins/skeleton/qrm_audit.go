@@ -0,0 +1,444 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package skeleton
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	pluginapi "k8s.io/kubelet/pkg/apis/resourceplugin/v1alpha1"
+
+	"github.com/kubewharf/katalyst-api/pkg/consts"
+)
+
+const (
+	defaultMaxAuditRecordsPerContainer = 32
+	defaultMaxAuditPods                = 1024
+
+	// QRMAuditQueryPodUID and the followings are the query parameters supported by the audit endpoint
+	QRMAuditQueryPodUID        = "podUID"
+	QRMAuditQueryContainerName = "containerName"
+	QRMAuditQueryPhase         = "phase"
+)
+
+// QRMAuditRecord is the record of a single rpc call to the qrm plugin, and the request and
+// response are deep-copied when recorded; responses of GetResourcesAllocation contain the
+// allocations of all the pods, so only a summary of them is kept in ResponseSummary instead.
+type QRMAuditRecord struct {
+	Phase           consts.QRMPhase `json:"phase"`
+	PodUID          string          `json:"podUID,omitempty"`
+	PodNamespace    string          `json:"podNamespace,omitempty"`
+	PodName         string          `json:"podName,omitempty"`
+	ContainerName   string          `json:"containerName,omitempty"`
+	StartTime       metav1.Time     `json:"startTime"`
+	Latency         metav1.Duration `json:"latency"`
+	Request         proto.Message   `json:"request,omitempty"`
+	Response        proto.Message   `json:"response,omitempty"`
+	ResponseSummary string          `json:"responseSummary,omitempty"`
+	Error           string          `json:"error,omitempty"`
+}
+
+// podAuditRecords keeps records of a pod by container name, and records of pod-level
+// calls (e.g. RemovePod) are kept with empty container name.
+type podAuditRecords map[string][]*QRMAuditRecord
+
+// QRMAuditTrail keeps the latest audit records of each container and node-level calls in memory;
+// when the number of pods exceeds the limit, records of the earliest seen pod are evicted.
+type QRMAuditTrail struct {
+	mutex                  sync.RWMutex
+	maxRecordsPerContainer int
+	maxPods                int
+
+	pods        map[string]podAuditRecords
+	podOrder    []string
+	nodeRecords []*QRMAuditRecord
+}
+
+// NewQRMAuditTrail returns an audit trail, and non-positive limits fall back to the defaults.
+func NewQRMAuditTrail(maxRecordsPerContainer, maxPods int) *QRMAuditTrail {
+	if maxRecordsPerContainer <= 0 {
+		maxRecordsPerContainer = defaultMaxAuditRecordsPerContainer
+	}
+	if maxPods <= 0 {
+		maxPods = defaultMaxAuditPods
+	}
+
+	return &QRMAuditTrail{
+		maxRecordsPerContainer: maxRecordsPerContainer,
+		maxPods:                maxPods,
+		pods:                   make(map[string]podAuditRecords),
+	}
+}
+
+// Add appends the record to the trail of its container, or node-level records if the pod uid is empty.
+func (t *QRMAuditTrail) Add(record *QRMAuditRecord) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if record.PodUID == "" {
+		t.nodeRecords = appendAuditRecord(t.nodeRecords, record, t.maxRecordsPerContainer)
+		return
+	}
+
+	pod, ok := t.pods[record.PodUID]
+	if !ok {
+		if evicted := len(t.podOrder) - t.maxPods + 1; evicted > 0 {
+			for _, uid := range t.podOrder[:evicted] {
+				delete(t.pods, uid)
+			}
+			// copy the rest so that the backing array doesn't keep growing
+			t.podOrder = append(make([]string, 0, t.maxPods), t.podOrder[evicted:]...)
+		}
+
+		pod = make(podAuditRecords)
+		t.pods[record.PodUID] = pod
+		t.podOrder = append(t.podOrder, record.PodUID)
+	}
+	pod[record.ContainerName] = appendAuditRecord(pod[record.ContainerName], record, t.maxRecordsPerContainer)
+}
+
+// Query returns the records sorted by start time; empty pod uid means node-level records,
+// and empty container name or nil phase means no filtering by them.
+func (t *QRMAuditTrail) Query(podUID, containerName string, phase *consts.QRMPhase) []*QRMAuditRecord {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	var candidates []*QRMAuditRecord
+	switch {
+	case podUID == "":
+		candidates = t.nodeRecords
+	case containerName == "":
+		for _, records := range t.pods[podUID] {
+			candidates = append(candidates, records...)
+		}
+	default:
+		// pod-level records are also included since they affect all the containers
+		candidates = append(candidates, t.pods[podUID][containerName]...)
+		candidates = append(candidates, t.pods[podUID][""]...)
+	}
+
+	result := make([]*QRMAuditRecord, 0, len(candidates))
+	for _, record := range candidates {
+		if phase == nil || record.Phase == *phase {
+			result = append(result, record)
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].StartTime.Before(&result[j].StartTime)
+	})
+	return result
+}
+
+// ServeHTTP returns the queried records as json, and the query parameters are podUID,
+// containerName and phase (the rpc name, such as Allocate).
+func (t *QRMAuditTrail) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, fmt.Sprintf("method %s is not allowed", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	query := r.URL.Query()
+	var phase *consts.QRMPhase
+	if name := query.Get(QRMAuditQueryPhase); name != "" {
+		p, err := consts.ParseQRMPhase(name)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		phase = &p
+	}
+
+	records := t.Query(query.Get(QRMAuditQueryPodUID), query.Get(QRMAuditQueryContainerName), phase)
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(records); err != nil {
+		klog.Errorf("encode qrm audit records failed: %v", err)
+	}
+}
+
+func appendAuditRecord(records []*QRMAuditRecord, record *QRMAuditRecord, limit int) []*QRMAuditRecord {
+	records = append(records, record)
+	if len(records) > limit {
+		records = append([]*QRMAuditRecord(nil), records[len(records)-limit:]...)
+	}
+	return records
+}
+
+// QRMAuditPlugin wraps a QRMPlugin to record each rpc call into the audit trail, so that
+// we can reconstruct why a container got its resources; the records can be queried
+// through http on the debug socket if it is set. The associated device calls are
+// recorded as the same phases with normal hints and allocation calls.
+type QRMAuditPlugin struct {
+	QRMPlugin
+
+	trail       *QRMAuditTrail
+	debugSocket string
+
+	mutex  sync.Mutex
+	server *http.Server
+}
+
+var _ QRMPlugin = &QRMAuditPlugin{}
+
+// NewQRMAuditPlugin wraps the plugin with the audit trail, and serves the audit endpoint
+// at debugSocket (a unix socket path) during the plugin is running if it is not empty.
+func NewQRMAuditPlugin(plugin QRMPlugin, trail *QRMAuditTrail, debugSocket string) *QRMAuditPlugin {
+	if trail == nil {
+		trail = NewQRMAuditTrail(0, 0)
+	}
+
+	return &QRMAuditPlugin{
+		QRMPlugin:   plugin,
+		trail:       trail,
+		debugSocket: debugSocket,
+	}
+}
+
+// AuditTrail returns the audit trail of this plugin
+func (p *QRMAuditPlugin) AuditTrail() *QRMAuditTrail {
+	return p.trail
+}
+
+// Start the wrapped plugin and the audit endpoint
+func (p *QRMAuditPlugin) Start() error {
+	if err := p.QRMPlugin.Start(); err != nil {
+		return err
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.debugSocket == "" || p.server != nil {
+		return nil
+	}
+
+	if err := os.Remove(p.debugSocket); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove audit socket %s: %v", p.debugSocket, err)
+	}
+
+	listener, err := net.Listen("unix", p.debugSocket)
+	if err != nil {
+		return fmt.Errorf("failed to listen audit socket %s: %v", p.debugSocket, err)
+	}
+
+	server := &http.Server{Handler: p.trail}
+	go func() {
+		if err := server.Serve(listener); err != nil && err != http.ErrServerClosed {
+			klog.Errorf("plugin %s audit server exited: %v", p.Name(), err)
+		}
+	}()
+	p.server = server
+
+	klog.Infof("plugin %s serves audit trail at %s", p.Name(), p.debugSocket)
+	return nil
+}
+
+// Stop the audit endpoint and the wrapped plugin
+func (p *QRMAuditPlugin) Stop() error {
+	p.mutex.Lock()
+	if p.server != nil {
+		if err := p.server.Close(); err != nil {
+			klog.Errorf("plugin %s close audit server failed: %v", p.Name(), err)
+		}
+		p.server = nil
+		_ = os.Remove(p.debugSocket)
+	}
+	p.mutex.Unlock()
+
+	return p.QRMPlugin.Stop()
+}
+
+// record adds the rpc call into the audit trail
+func (p *QRMAuditPlugin) record(phase consts.QRMPhase, podUID, podNamespace, podName, containerName string,
+	start time.Time, req, resp proto.Message, err error) {
+	p.trail.Add(newAuditRecord(phase, podUID, podNamespace, podName, containerName, start, req, resp, err))
+}
+
+func newAuditRecord(phase consts.QRMPhase, podUID, podNamespace, podName, containerName string,
+	start time.Time, req, resp proto.Message, err error) *QRMAuditRecord {
+	record := &QRMAuditRecord{
+		Phase:         phase,
+		PodUID:        podUID,
+		PodNamespace:  podNamespace,
+		PodName:       podName,
+		ContainerName: containerName,
+		StartTime:     metav1.NewTime(start),
+		Latency:       metav1.Duration{Duration: time.Since(start)},
+		Request:       cloneAuditMessage(req),
+		Response:      cloneAuditMessage(resp),
+	}
+	if err != nil {
+		record.Error = err.Error()
+	}
+	return record
+}
+
+// GetTopologyHints calls the wrapped plugin and records the call
+func (p *QRMAuditPlugin) GetTopologyHints(ctx context.Context, req *pluginapi.ResourceRequest) (*pluginapi.ResourceHintsResponse, error) {
+	start := time.Now()
+	resp, err := p.QRMPlugin.GetTopologyHints(ctx, req)
+	p.record(consts.QRMPhaseGetTopologyHints, req.GetPodUid(), req.GetPodNamespace(), req.GetPodName(),
+		req.GetContainerName(), start, req, resp, err)
+	return resp, err
+}
+
+// GetPodTopologyHints calls the wrapped plugin and records the call
+func (p *QRMAuditPlugin) GetPodTopologyHints(ctx context.Context, req *pluginapi.PodResourceRequest) (*pluginapi.PodResourceHintsResponse, error) {
+	start := time.Now()
+	resp, err := p.QRMPlugin.GetPodTopologyHints(ctx, req)
+	p.record(consts.QRMPhaseGetTopologyHints, req.GetPodUid(), req.GetPodNamespace(), req.GetPodName(),
+		"", start, req, resp, err)
+	return resp, err
+}
+
+// RemovePod calls the wrapped plugin and records the call
+func (p *QRMAuditPlugin) RemovePod(ctx context.Context, req *pluginapi.RemovePodRequest) (*pluginapi.RemovePodResponse, error) {
+	start := time.Now()
+	resp, err := p.QRMPlugin.RemovePod(ctx, req)
+	p.record(consts.QRMPhaseRemovePod, req.GetPodUid(), "", "", "", start, req, resp, err)
+	return resp, err
+}
+
+// GetResourcesAllocation calls the wrapped plugin and records the call
+func (p *QRMAuditPlugin) GetResourcesAllocation(ctx context.Context, req *pluginapi.GetResourcesAllocationRequest) (*pluginapi.GetResourcesAllocationResponse, error) {
+	start := time.Now()
+	resp, err := p.QRMPlugin.GetResourcesAllocation(ctx, req)
+	record := newAuditRecord(consts.QRMPhaseGetResourcesAllocation, "", "", "", "", start, req, nil, err)
+	if resp != nil {
+		record.ResponseSummary = summarizeResourcesAllocation(resp)
+	}
+	p.trail.Add(record)
+	return resp, err
+}
+
+// GetTopologyAwareResources calls the wrapped plugin and records the call
+func (p *QRMAuditPlugin) GetTopologyAwareResources(ctx context.Context, req *pluginapi.GetTopologyAwareResourcesRequest) (*pluginapi.GetTopologyAwareResourcesResponse, error) {
+	start := time.Now()
+	resp, err := p.QRMPlugin.GetTopologyAwareResources(ctx, req)
+	p.record(consts.QRMPhaseGetTopologyAwareResources, req.GetPodUid(), resp.GetPodNamespace(), resp.GetPodName(),
+		req.GetContainerName(), start, req, resp, err)
+	return resp, err
+}
+
+// GetTopologyAwareAllocatableResources calls the wrapped plugin and records the call
+func (p *QRMAuditPlugin) GetTopologyAwareAllocatableResources(ctx context.Context,
+	req *pluginapi.GetTopologyAwareAllocatableResourcesRequest) (*pluginapi.GetTopologyAwareAllocatableResourcesResponse, error) {
+	start := time.Now()
+	resp, err := p.QRMPlugin.GetTopologyAwareAllocatableResources(ctx, req)
+	p.record(consts.QRMPhaseGetTopologyAwareAllocatableResources, "", "", "", "", start, req, resp, err)
+	return resp, err
+}
+
+// GetResourcePluginOptions calls the wrapped plugin and records the call
+func (p *QRMAuditPlugin) GetResourcePluginOptions(ctx context.Context, req *pluginapi.Empty) (*pluginapi.ResourcePluginOptions, error) {
+	start := time.Now()
+	resp, err := p.QRMPlugin.GetResourcePluginOptions(ctx, req)
+	p.record(consts.QRMPhaseGetResourcePluginOptions, "", "", "", "", start, req, resp, err)
+	return resp, err
+}
+
+// Allocate calls the wrapped plugin and records the call
+func (p *QRMAuditPlugin) Allocate(ctx context.Context, req *pluginapi.ResourceRequest) (*pluginapi.ResourceAllocationResponse, error) {
+	start := time.Now()
+	resp, err := p.QRMPlugin.Allocate(ctx, req)
+	p.record(consts.QRMPhaseAllocate, req.GetPodUid(), req.GetPodNamespace(), req.GetPodName(),
+		req.GetContainerName(), start, req, resp, err)
+	return resp, err
+}
+
+// AllocateForPod calls the wrapped plugin and records the call
+func (p *QRMAuditPlugin) AllocateForPod(ctx context.Context, req *pluginapi.PodResourceRequest) (*pluginapi.PodResourceAllocationResponse, error) {
+	start := time.Now()
+	resp, err := p.QRMPlugin.AllocateForPod(ctx, req)
+	p.record(consts.QRMPhaseAllocate, req.GetPodUid(), req.GetPodNamespace(), req.GetPodName(),
+		"", start, req, resp, err)
+	return resp, err
+}
+
+// PreStartContainer calls the wrapped plugin and records the call
+func (p *QRMAuditPlugin) PreStartContainer(ctx context.Context, req *pluginapi.PreStartContainerRequest) (*pluginapi.PreStartContainerResponse, error) {
+	start := time.Now()
+	resp, err := p.QRMPlugin.PreStartContainer(ctx, req)
+	p.record(consts.QRMPhasePreStartContainer, req.GetPodUid(), req.GetPodNamespace(), req.GetPodName(),
+		req.GetContainerName(), start, req, resp, err)
+	return resp, err
+}
+
+// GetAssociatedDeviceTopologyHints calls the wrapped plugin and records the call
+func (p *QRMAuditPlugin) GetAssociatedDeviceTopologyHints(ctx context.Context,
+	req *pluginapi.AssociatedDeviceRequest) (*pluginapi.AssociatedDeviceHintsResponse, error) {
+	start := time.Now()
+	resp, err := p.QRMPlugin.GetAssociatedDeviceTopologyHints(ctx, req)
+	r := req.GetResourceRequest()
+	p.record(consts.QRMPhaseGetTopologyHints, r.GetPodUid(), r.GetPodNamespace(), r.GetPodName(),
+		r.GetContainerName(), start, req, resp, err)
+	return resp, err
+}
+
+// AllocateAssociatedDevice calls the wrapped plugin and records the call
+func (p *QRMAuditPlugin) AllocateAssociatedDevice(ctx context.Context,
+	req *pluginapi.AssociatedDeviceRequest) (*pluginapi.AssociatedDeviceAllocationResponse, error) {
+	start := time.Now()
+	resp, err := p.QRMPlugin.AllocateAssociatedDevice(ctx, req)
+	r := req.GetResourceRequest()
+	p.record(consts.QRMPhaseAllocate, r.GetPodUid(), r.GetPodNamespace(), r.GetPodName(),
+		r.GetContainerName(), start, req, resp, err)
+	return resp, err
+}
+
+// cloneAuditMessage deep-copies the message since the plugin may modify it after returning,
+// and returns nil for nil pointers.
+func cloneAuditMessage(msg proto.Message) proto.Message {
+	if msg == nil || reflect.ValueOf(msg).IsNil() {
+		return nil
+	}
+	return proto.Clone(msg)
+}
+
+// summarizeResourcesAllocation returns the numbers of pods and containers and the names of
+// resources in the response, whose size doesn't grow with the number of pods.
+func summarizeResourcesAllocation(resp *pluginapi.GetResourcesAllocationResponse) string {
+	containers := 0
+	resourceNames := make(map[string]bool)
+	for _, pod := range resp.GetPodResources() {
+		for _, container := range pod.GetContainerResources() {
+			containers++
+			for name := range container.GetResourceAllocation() {
+				resourceNames[name] = true
+			}
+		}
+	}
+
+	names := make([]string, 0, len(resourceNames))
+	for name := range resourceNames {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return fmt.Sprintf("%d pods, %d containers, resources %v", len(resp.GetPodResources()), containers, names)
+}
@@ -17,10 +17,6 @@ limitations under the License.
 package skeleton
 
 import (
-	"context"
-
-	"k8s.io/klog/v2"
-
 	"github.com/kubewharf/katalyst-api/pkg/protocol/reporterplugin/v1alpha1"
 )
 
@@ -52,17 +48,10 @@ var _ ReporterPlugin = &DummyReporterPlugin{
 	v1alpha1.UnimplementedReporterPluginServer{},
 }
 
-// ReporterPluginStub is a stub for test reporter plugin manager
+// ReporterPluginStub is a stub for test reporter plugin manager, and it sends
+// each update immediately without coalescing.
 type ReporterPluginStub struct {
-	started bool
-	stop    chan struct{}
-	update  chan []*v1alpha1.ReportContent
-
-	content []*v1alpha1.ReportContent
-	socket  string
-	name    string
-
-	v1alpha1.UnimplementedReporterPluginServer
+	*ReporterPluginBase
 }
 
 var _ ReporterPlugin = &ReporterPluginStub{}
@@ -70,79 +59,6 @@ var _ ReporterPlugin = &ReporterPluginStub{}
 // NewReporterPluginStub initialize a reporter plugin stub which will report the input content.
 func NewReporterPluginStub(content []*v1alpha1.ReportContent, name string) *ReporterPluginStub {
 	return &ReporterPluginStub{
-		started: false,
-		content: content,
-		name:    name,
-		update:  make(chan []*v1alpha1.ReportContent),
-	}
-}
-
-// Name of reporter plugin stub
-func (m *ReporterPluginStub) Name() string {
-	return m.name
-}
-
-// GetReportContent get report content from cache
-func (m *ReporterPluginStub) GetReportContent(_ context.Context, _ *v1alpha1.Empty) (*v1alpha1.GetReportContentResponse, error) {
-	return &v1alpha1.GetReportContentResponse{
-		Content: m.content,
-	}, nil
-}
-
-// ListAndWatchReportContent watch updateCh channel and send report content to plugin manager
-func (m *ReporterPluginStub) ListAndWatchReportContent(empty *v1alpha1.Empty, server v1alpha1.ReporterPlugin_ListAndWatchReportContentServer) error {
-	klog.Infof("plugin %s ListAndWatchReportContent", m.name)
-
-	_ = server.Send(&v1alpha1.GetReportContentResponse{
-		Content: m.content,
-	})
-
-	for {
-		select {
-		case <-m.stop:
-			return nil
-		case updated := <-m.update:
-			err := server.Send(&v1alpha1.GetReportContentResponse{
-				Content: updated,
-			})
-			if err != nil {
-				klog.Errorf("plugin %s ListAndWatchReportContent send response failed, %v", m.name, err)
-			}
-		}
-	}
-}
-
-// Update send report content to trigger list/watch
-func (m *ReporterPluginStub) Update(content []*v1alpha1.ReportContent) {
-	m.update <- content
-}
-
-// Start initialize some variables to start plugin
-func (m *ReporterPluginStub) Start() (err error) {
-	defer func() {
-		if err == nil {
-			m.started = true
-		}
-	}()
-
-	if m.started {
-		return
+		ReporterPluginBase: NewReporterPluginBase(name, content, 0),
 	}
-
-	m.stop = make(chan struct{})
-	return
-}
-
-// Stop clean some variables when stop plugin
-func (m *ReporterPluginStub) Stop() error {
-	defer func() {
-		m.started = false
-	}()
-
-	if !m.started {
-		return nil
-	}
-
-	close(m.stop)
-	return nil
 }
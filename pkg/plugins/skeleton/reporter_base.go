@@ -0,0 +1,286 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package skeleton
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"github.com/kubewharf/katalyst-api/pkg/protocol/reporterplugin/v1alpha1"
+	"github.com/kubewharf/katalyst-api/pkg/utils"
+)
+
+// ReporterPluginBase is a base implementation of ReporterPlugin, and real reporter plugins
+// can embed it and call Update whenever their report content changes. It caches the latest
+// content, drops no-op updates by content hash, and broadcasts changes to all the watchers,
+// while updates within the coalescing window are merged into a single response.
+type ReporterPluginBase struct {
+	name           string
+	coalesceWindow time.Duration
+
+	mutex     sync.RWMutex
+	started   bool
+	stop      chan struct{}
+	validator *utils.ReportContentValidator
+
+	// changed is closed and replaced each time the content changes to notify all the watchers
+	changed         chan struct{}
+	content         []*v1alpha1.ReportContent
+	contentHash     string
+	version         uint64
+	lastSentVersion uint64
+
+	v1alpha1.UnimplementedReporterPluginServer
+}
+
+var _ ReporterPlugin = &ReporterPluginBase{}
+
+// NewReporterPluginBase initialize a reporter plugin base with the initial content, and each
+// watcher sends at most one response within coalesceWindow; zero window disables coalescing.
+func NewReporterPluginBase(name string, content []*v1alpha1.ReportContent, coalesceWindow time.Duration) *ReporterPluginBase {
+	return &ReporterPluginBase{
+		name:           name,
+		coalesceWindow: coalesceWindow,
+		changed:        make(chan struct{}),
+		content:        content,
+		contentHash:    hashReportContent(content),
+		version:        1,
+	}
+}
+
+// Name of reporter plugin
+func (b *ReporterPluginBase) Name() string {
+	return b.name
+}
+
+// SetValidator sets the validator to check report content locally before sending,
+// and invalid content will be rejected if validator is set.
+func (b *ReporterPluginBase) SetValidator(validator *utils.ReportContentValidator) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.validator = validator
+}
+
+// Version returns the version of current report content, which increases by one for each change.
+func (b *ReporterPluginBase) Version() uint64 {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+	return b.version
+}
+
+// LastSentVersion returns the latest content version that has been sent to any watcher,
+// and returns zero if nothing has been sent yet.
+func (b *ReporterPluginBase) LastSentVersion() uint64 {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+	return b.lastSentVersion
+}
+
+// Update replaces the report content and notifies all the watchers; the content will be
+// dropped if it is invalid or has the same hash as the current one.
+func (b *ReporterPluginBase) Update(content []*v1alpha1.ReportContent) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.validator != nil {
+		if err := b.validator.Validate(content); err != nil {
+			klog.Errorf("plugin %s drops invalid report content: %v", b.name, err)
+			return
+		}
+	}
+
+	hash := hashReportContent(content)
+	if hash != "" && hash == b.contentHash {
+		klog.V(4).Infof("plugin %s drops no-op report content update", b.name)
+		return
+	}
+
+	b.content, b.contentHash = content, hash
+	b.version++
+	close(b.changed)
+	b.changed = make(chan struct{})
+}
+
+// GetReportContent get report content from cache
+func (b *ReporterPluginBase) GetReportContent(_ context.Context, _ *v1alpha1.Empty) (*v1alpha1.GetReportContentResponse, error) {
+	content, _, _ := b.snapshot()
+
+	b.mutex.RLock()
+	validator := b.validator
+	b.mutex.RUnlock()
+
+	if validator != nil {
+		if err := validator.Validate(content); err != nil {
+			return nil, err
+		}
+	}
+
+	return &v1alpha1.GetReportContentResponse{
+		Content: content,
+	}, nil
+}
+
+// ListAndWatchReportContent sends the full report content to plugin manager each time it changes
+func (b *ReporterPluginBase) ListAndWatchReportContent(_ *v1alpha1.Empty, server v1alpha1.ReporterPlugin_ListAndWatchReportContentServer) error {
+	klog.Infof("plugin %s ListAndWatchReportContent", b.name)
+
+	return b.watch(server.Context(), "ListAndWatchReportContent", func(content []*v1alpha1.ReportContent, _ uint64) (bool, error) {
+		return true, server.Send(&v1alpha1.GetReportContentResponse{
+			Content: content,
+		})
+	})
+}
+
+// ListAndWatchVersionedReportContent sends versioned report content to plugin manager, the first
+// response is a full snapshot and the following ones only contain the delta of report content.
+func (b *ReporterPluginBase) ListAndWatchVersionedReportContent(_ *v1alpha1.Empty, server v1alpha1.ReporterPlugin_ListAndWatchVersionedReportContentServer) error {
+	klog.Infof("plugin %s ListAndWatchVersionedReportContent", b.name)
+
+	generator := &versionedReportContentGenerator{}
+	return b.watch(server.Context(), "ListAndWatchVersionedReportContent", func(content []*v1alpha1.ReportContent, version uint64) (bool, error) {
+		resp := generator.next(version, content)
+		if resp == nil {
+			return false, nil
+		}
+		return true, server.Send(resp)
+	})
+}
+
+// watch sends the current content at once and then each time it changes, until the plugin
+// is stopped or the stream is closed; sends are delayed to be at least coalesceWindow apart,
+// so that all the updates during that period are merged into the latest one. send returns
+// whether a response is actually written, and only written versions are marked as sent.
+func (b *ReporterPluginBase) watch(ctx context.Context, method string,
+	send func(content []*v1alpha1.ReportContent, version uint64) (bool, error)) error {
+	stop := b.getStopCh()
+	if stop == nil {
+		return fmt.Errorf("plugin %s is not started", b.name)
+	}
+
+	var lastSent time.Time
+	content, version, changed := b.snapshot()
+	for {
+		sent, err := send(content, version)
+		if err != nil {
+			klog.Errorf("plugin %s %s send response failed, %v", b.name, method, err)
+			return err
+		}
+		if sent {
+			lastSent = time.Now()
+			b.markSent(version)
+		}
+
+		select {
+		case <-stop:
+			return nil
+		case <-ctx.Done():
+			klog.Infof("plugin %s %s stream closed", b.name, method)
+			return nil
+		case <-changed:
+		}
+
+		if wait := b.coalesceWindow - time.Since(lastSent); wait > 0 {
+			timer := time.NewTimer(wait)
+			select {
+			case <-stop:
+				timer.Stop()
+				return nil
+			case <-ctx.Done():
+				timer.Stop()
+				klog.Infof("plugin %s %s stream closed", b.name, method)
+				return nil
+			case <-timer.C:
+			}
+		}
+
+		content, version, changed = b.snapshot()
+	}
+}
+
+// snapshot returns the current content with its version, and the channel to be notified when it changes
+func (b *ReporterPluginBase) snapshot() ([]*v1alpha1.ReportContent, uint64, <-chan struct{}) {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+	return b.content, b.version, b.changed
+}
+
+func (b *ReporterPluginBase) markSent(version uint64) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if version > b.lastSentVersion {
+		b.lastSentVersion = version
+	}
+}
+
+// getStopCh returns the stop channel of current running round
+func (b *ReporterPluginBase) getStopCh() chan struct{} {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+	return b.stop
+}
+
+// Start initialize some variables to start plugin
+func (b *ReporterPluginBase) Start() error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.started {
+		return nil
+	}
+
+	b.stop = make(chan struct{})
+	b.started = true
+	return nil
+}
+
+// Stop closes the stop channel to terminate all the watchers
+func (b *ReporterPluginBase) Stop() error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if !b.started {
+		return nil
+	}
+
+	close(b.stop)
+	b.started = false
+	return nil
+}
+
+// hashReportContent returns the hash of serialized report content, and returns empty
+// string if the content can't be serialized, which is always regarded as changed.
+func hashReportContent(content []*v1alpha1.ReportContent) string {
+	for _, c := range content {
+		if c == nil {
+			return ""
+		}
+	}
+
+	data, err := (&v1alpha1.GetReportContentResponse{Content: content}).Marshal()
+	if err != nil {
+		return ""
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,272 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package skeleton
+
+import (
+	"bytes"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubewharf/katalyst-api/pkg/protocol/reporterplugin/v1alpha1"
+)
+
+// reportContentKey identifies the target object of report contents
+type reportContentKey struct {
+	gvk       metav1.GroupVersionKind
+	namespace string
+	name      string
+}
+
+func newReportContentKey(gvk *metav1.GroupVersionKind, ref *v1alpha1.ObjectReference) reportContentKey {
+	var key reportContentKey
+	if gvk != nil {
+		key.gvk = *gvk
+	}
+	key.namespace, key.name = ref.GetNamespace(), ref.GetName()
+	return key
+}
+
+// groupVersionKind returns a newly allocated gvk of this key
+func (k reportContentKey) groupVersionKind() *metav1.GroupVersionKind {
+	gvk := k.gvk
+	return &gvk
+}
+
+// objectReference returns a newly allocated object reference of this key,
+// and returns nil for the node-level object
+func (k reportContentKey) objectReference() *v1alpha1.ObjectReference {
+	if k.namespace == "" && k.name == "" {
+		return nil
+	}
+	return &v1alpha1.ObjectReference{Namespace: k.namespace, Name: k.name}
+}
+
+func (k reportContentKey) String() string {
+	if k.namespace == "" && k.name == "" {
+		return k.gvk.String()
+	}
+	return fmt.Sprintf("%s %s/%s", k.gvk.String(), k.namespace, k.name)
+}
+
+// reportFieldKey identifies a single field of the target object
+type reportFieldKey struct {
+	fieldType v1alpha1.FieldType
+	fieldName string
+}
+
+// reportContentIndex groups report fields by target object, and keeps the order
+// of both target objects and fields to make the generated delta deterministic
+type reportContentIndex struct {
+	contentKeys []reportContentKey
+	fieldKeys   map[reportContentKey][]reportFieldKey
+	fields      map[reportContentKey]map[reportFieldKey]*v1alpha1.ReportField
+}
+
+func newReportContentIndex(contents []*v1alpha1.ReportContent) *reportContentIndex {
+	index := &reportContentIndex{
+		fieldKeys: make(map[reportContentKey][]reportFieldKey),
+		fields:    make(map[reportContentKey]map[reportFieldKey]*v1alpha1.ReportField),
+	}
+
+	for _, content := range contents {
+		if content == nil {
+			continue
+		}
+
+		contentKey := newReportContentKey(content.GroupVersionKind, content.ObjectReference)
+		index.addContentKey(contentKey)
+
+		for _, field := range content.Field {
+			if field == nil {
+				continue
+			}
+
+			fieldKey := reportFieldKey{fieldType: field.FieldType, fieldName: field.FieldName}
+			if _, ok := index.fields[contentKey][fieldKey]; !ok {
+				index.fieldKeys[contentKey] = append(index.fieldKeys[contentKey], fieldKey)
+			}
+			// the latter field overwrites the former one with the same key
+			index.fields[contentKey][fieldKey] = field
+		}
+	}
+
+	return index
+}
+
+func (i *reportContentIndex) addContentKey(contentKey reportContentKey) {
+	if _, ok := i.fields[contentKey]; !ok {
+		i.contentKeys = append(i.contentKeys, contentKey)
+		i.fields[contentKey] = make(map[reportFieldKey]*v1alpha1.ReportField)
+	}
+}
+
+// contents converts the index back to report contents
+func (i *reportContentIndex) contents() []*v1alpha1.ReportContent {
+	contents := make([]*v1alpha1.ReportContent, 0, len(i.contentKeys))
+	for _, contentKey := range i.contentKeys {
+		if len(i.fieldKeys[contentKey]) == 0 {
+			continue
+		}
+
+		content := &v1alpha1.ReportContent{
+			GroupVersionKind: contentKey.groupVersionKind(),
+			ObjectReference:  contentKey.objectReference(),
+		}
+		for _, fieldKey := range i.fieldKeys[contentKey] {
+			content.Field = append(content.Field, i.fields[contentKey][fieldKey])
+		}
+		contents = append(contents, content)
+	}
+	return contents
+}
+
+// GenerateReportContentDelta compares two successive report content snapshots and returns the
+// field-level changes needed to transform the previous snapshot into the current one.
+func GenerateReportContentDelta(previous, current []*v1alpha1.ReportContent) []*v1alpha1.ReportContentDelta {
+	previousIndex := newReportContentIndex(previous)
+	currentIndex := newReportContentIndex(current)
+
+	deltaMap := make(map[reportContentKey]*v1alpha1.ReportContentDelta)
+	var deltaList []*v1alpha1.ReportContentDelta
+	addFieldDelta := func(contentKey reportContentKey, operation v1alpha1.FieldOperation, field *v1alpha1.ReportField) {
+		delta, ok := deltaMap[contentKey]
+		if !ok {
+			delta = &v1alpha1.ReportContentDelta{
+				GroupVersionKind: contentKey.groupVersionKind(),
+				ObjectReference:  contentKey.objectReference(),
+			}
+			deltaMap[contentKey] = delta
+			deltaList = append(deltaList, delta)
+		}
+		delta.Field = append(delta.Field, &v1alpha1.ReportFieldDelta{
+			Operation: operation,
+			Field:     field,
+		})
+	}
+
+	for _, contentKey := range currentIndex.contentKeys {
+		for _, fieldKey := range currentIndex.fieldKeys[contentKey] {
+			field := currentIndex.fields[contentKey][fieldKey]
+			previousField, ok := previousIndex.fields[contentKey][fieldKey]
+			if !ok {
+				addFieldDelta(contentKey, v1alpha1.FieldOperation_Add, field)
+			} else if !bytes.Equal(previousField.Value, field.Value) {
+				addFieldDelta(contentKey, v1alpha1.FieldOperation_Update, field)
+			}
+		}
+	}
+
+	for _, contentKey := range previousIndex.contentKeys {
+		for _, fieldKey := range previousIndex.fieldKeys[contentKey] {
+			if _, ok := currentIndex.fields[contentKey][fieldKey]; !ok {
+				addFieldDelta(contentKey, v1alpha1.FieldOperation_Delete, &v1alpha1.ReportField{
+					FieldType: fieldKey.fieldType,
+					FieldName: fieldKey.fieldName,
+				})
+			}
+		}
+	}
+
+	return deltaList
+}
+
+// ApplyReportContentDelta applies the field-level changes to the given report content snapshot
+// and returns the new snapshot; the input snapshot won't be modified.
+func ApplyReportContentDelta(contents []*v1alpha1.ReportContent, deltaList []*v1alpha1.ReportContentDelta) ([]*v1alpha1.ReportContent, error) {
+	index := newReportContentIndex(contents)
+
+	for _, delta := range deltaList {
+		if delta == nil {
+			continue
+		}
+
+		contentKey := newReportContentKey(delta.GroupVersionKind, delta.ObjectReference)
+		index.addContentKey(contentKey)
+
+		for _, fieldDelta := range delta.Field {
+			if fieldDelta == nil || fieldDelta.Field == nil {
+				continue
+			}
+
+			fieldKey := reportFieldKey{fieldType: fieldDelta.Field.FieldType, fieldName: fieldDelta.Field.FieldName}
+			_, exist := index.fields[contentKey][fieldKey]
+			switch fieldDelta.Operation {
+			case v1alpha1.FieldOperation_Add:
+				if exist {
+					return nil, fmt.Errorf("add field %v/%s of %v failed: already exists", fieldKey.fieldType, fieldKey.fieldName, contentKey)
+				}
+				index.fieldKeys[contentKey] = append(index.fieldKeys[contentKey], fieldKey)
+				index.fields[contentKey][fieldKey] = fieldDelta.Field
+			case v1alpha1.FieldOperation_Update:
+				if !exist {
+					return nil, fmt.Errorf("update field %v/%s of %v failed: not found", fieldKey.fieldType, fieldKey.fieldName, contentKey)
+				}
+				index.fields[contentKey][fieldKey] = fieldDelta.Field
+			case v1alpha1.FieldOperation_Delete:
+				if !exist {
+					return nil, fmt.Errorf("delete field %v/%s of %v failed: not found", fieldKey.fieldType, fieldKey.fieldName, contentKey)
+				}
+				delete(index.fields[contentKey], fieldKey)
+				for i := range index.fieldKeys[contentKey] {
+					if index.fieldKeys[contentKey][i] == fieldKey {
+						index.fieldKeys[contentKey] = append(index.fieldKeys[contentKey][:i], index.fieldKeys[contentKey][i+1:]...)
+						break
+					}
+				}
+			default:
+				return nil, fmt.Errorf("unknown field operation %v", fieldDelta.Operation)
+			}
+		}
+	}
+
+	return index.contents(), nil
+}
+
+// versionedReportContentGenerator generates versioned responses from successive
+// report content snapshots, the first response is always a full snapshot and
+// the followings only contain the delta compared with the previous snapshot.
+type versionedReportContentGenerator struct {
+	initialized bool
+	previous    []*v1alpha1.ReportContent
+}
+
+// next returns the response for the given snapshot with its version, and returns nil if
+// nothing changed; the version must be larger than the one of previous snapshot.
+func (g *versionedReportContentGenerator) next(version uint64, current []*v1alpha1.ReportContent) *v1alpha1.VersionedReportContentResponse {
+	if !g.initialized {
+		g.initialized = true
+		g.previous = current
+		return &v1alpha1.VersionedReportContentResponse{
+			ResourceVersion: version,
+			Type:            v1alpha1.ResponseType_Snapshot,
+			Content:         current,
+		}
+	}
+
+	delta := GenerateReportContentDelta(g.previous, current)
+	if len(delta) == 0 {
+		return nil
+	}
+
+	g.previous = current
+	return &v1alpha1.VersionedReportContentResponse{
+		ResourceVersion: version,
+		Type:            v1alpha1.ResponseType_Delta,
+		Delta:           delta,
+	}
+}
@@ -74,6 +74,59 @@ func (FieldType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_78941759e4c5eff9, []int{0}
 }
 
+type ResponseType int32
+
+const (
+	ResponseType_Snapshot ResponseType = 0
+	ResponseType_Delta    ResponseType = 1
+)
+
+var ResponseType_name = map[int32]string{
+	0: "Snapshot",
+	1: "Delta",
+}
+
+var ResponseType_value = map[string]int32{
+	"Snapshot": 0,
+	"Delta":    1,
+}
+
+func (x ResponseType) String() string {
+	return proto.EnumName(ResponseType_name, int32(x))
+}
+
+func (ResponseType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_78941759e4c5eff9, []int{1}
+}
+
+type FieldOperation int32
+
+const (
+	FieldOperation_Add    FieldOperation = 0
+	FieldOperation_Update FieldOperation = 1
+	FieldOperation_Delete FieldOperation = 2
+)
+
+var FieldOperation_name = map[int32]string{
+	0: "Add",
+	1: "Update",
+	2: "Delete",
+}
+
+var FieldOperation_value = map[string]int32{
+	"Add":    0,
+	"Update": 1,
+	"Delete": 2,
+}
+
+func (x FieldOperation) String() string {
+	return proto.EnumName(FieldOperation_name, int32(x))
+}
+
+func (FieldOperation) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_78941759e4c5eff9, []int{2}
+}
+
 type Empty struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -111,9 +164,63 @@ func (m *Empty) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Empty proto.InternalMessageInfo
 
+type ObjectReference struct {
+	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObjectReference) Reset()      { *m = ObjectReference{} }
+func (*ObjectReference) ProtoMessage() {}
+func (*ObjectReference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78941759e4c5eff9, []int{1}
+}
+func (m *ObjectReference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ObjectReference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ObjectReference.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ObjectReference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectReference.Merge(m, src)
+}
+func (m *ObjectReference) XXX_Size() int {
+	return m.Size()
+}
+func (m *ObjectReference) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectReference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectReference proto.InternalMessageInfo
+
+func (m *ObjectReference) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *ObjectReference) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
 type ReportContent struct {
 	GroupVersionKind     *v1.GroupVersionKind `protobuf:"bytes,1,opt,name=groupVersionKind,proto3" json:"groupVersionKind,omitempty"`
 	Field                []*ReportField       `protobuf:"bytes,2,rep,name=field,proto3" json:"field,omitempty"`
+	ObjectReference      *ObjectReference     `protobuf:"bytes,3,opt,name=objectReference,proto3" json:"objectReference,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
 }
@@ -121,7 +228,7 @@ type ReportContent struct {
 func (m *ReportContent) Reset()      { *m = ReportContent{} }
 func (*ReportContent) ProtoMessage() {}
 func (*ReportContent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_78941759e4c5eff9, []int{1}
+	return fileDescriptor_78941759e4c5eff9, []int{2}
 }
 func (m *ReportContent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -164,6 +271,13 @@ func (m *ReportContent) GetField() []*ReportField {
 	return nil
 }
 
+func (m *ReportContent) GetObjectReference() *ObjectReference {
+	if m != nil {
+		return m.ObjectReference
+	}
+	return nil
+}
+
 type ReportField struct {
 	FieldType            FieldType `protobuf:"varint,1,opt,name=fieldType,proto3,enum=reporterplugin.v1alpha1.FieldType" json:"fieldType,omitempty"`
 	FieldName            string    `protobuf:"bytes,2,opt,name=fieldName,proto3" json:"fieldName,omitempty"`
@@ -175,7 +289,7 @@ type ReportField struct {
 func (m *ReportField) Reset()      { *m = ReportField{} }
 func (*ReportField) ProtoMessage() {}
 func (*ReportField) Descriptor() ([]byte, []int) {
-	return fileDescriptor_78941759e4c5eff9, []int{2}
+	return fileDescriptor_78941759e4c5eff9, []int{3}
 }
 func (m *ReportField) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -234,7 +348,7 @@ type GetReportContentResponse struct {
 func (m *GetReportContentResponse) Reset()      { *m = GetReportContentResponse{} }
 func (*GetReportContentResponse) ProtoMessage() {}
 func (*GetReportContentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_78941759e4c5eff9, []int{3}
+	return fileDescriptor_78941759e4c5eff9, []int{4}
 }
 func (m *GetReportContentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -270,48 +384,252 @@ func (m *GetReportContentResponse) GetContent() []*ReportContent {
 	return nil
 }
 
+type ReportFieldDelta struct {
+	Operation            FieldOperation `protobuf:"varint,1,opt,name=operation,proto3,enum=reporterplugin.v1alpha1.FieldOperation" json:"operation,omitempty"`
+	Field                *ReportField   `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ReportFieldDelta) Reset()      { *m = ReportFieldDelta{} }
+func (*ReportFieldDelta) ProtoMessage() {}
+func (*ReportFieldDelta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78941759e4c5eff9, []int{5}
+}
+func (m *ReportFieldDelta) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReportFieldDelta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReportFieldDelta.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReportFieldDelta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportFieldDelta.Merge(m, src)
+}
+func (m *ReportFieldDelta) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReportFieldDelta) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportFieldDelta.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReportFieldDelta proto.InternalMessageInfo
+
+func (m *ReportFieldDelta) GetOperation() FieldOperation {
+	if m != nil {
+		return m.Operation
+	}
+	return FieldOperation_Add
+}
+
+func (m *ReportFieldDelta) GetField() *ReportField {
+	if m != nil {
+		return m.Field
+	}
+	return nil
+}
+
+type ReportContentDelta struct {
+	GroupVersionKind     *v1.GroupVersionKind `protobuf:"bytes,1,opt,name=groupVersionKind,proto3" json:"groupVersionKind,omitempty"`
+	Field                []*ReportFieldDelta  `protobuf:"bytes,2,rep,name=field,proto3" json:"field,omitempty"`
+	ObjectReference      *ObjectReference     `protobuf:"bytes,3,opt,name=objectReference,proto3" json:"objectReference,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ReportContentDelta) Reset()      { *m = ReportContentDelta{} }
+func (*ReportContentDelta) ProtoMessage() {}
+func (*ReportContentDelta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78941759e4c5eff9, []int{6}
+}
+func (m *ReportContentDelta) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReportContentDelta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReportContentDelta.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReportContentDelta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportContentDelta.Merge(m, src)
+}
+func (m *ReportContentDelta) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReportContentDelta) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportContentDelta.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReportContentDelta proto.InternalMessageInfo
+
+func (m *ReportContentDelta) GetGroupVersionKind() *v1.GroupVersionKind {
+	if m != nil {
+		return m.GroupVersionKind
+	}
+	return nil
+}
+
+func (m *ReportContentDelta) GetField() []*ReportFieldDelta {
+	if m != nil {
+		return m.Field
+	}
+	return nil
+}
+
+func (m *ReportContentDelta) GetObjectReference() *ObjectReference {
+	if m != nil {
+		return m.ObjectReference
+	}
+	return nil
+}
+
+type VersionedReportContentResponse struct {
+	ResourceVersion      uint64                `protobuf:"varint,1,opt,name=resourceVersion,proto3" json:"resourceVersion,omitempty"`
+	Type                 ResponseType          `protobuf:"varint,2,opt,name=type,proto3,enum=reporterplugin.v1alpha1.ResponseType" json:"type,omitempty"`
+	Content              []*ReportContent      `protobuf:"bytes,3,rep,name=content,proto3" json:"content,omitempty"`
+	Delta                []*ReportContentDelta `protobuf:"bytes,4,rep,name=delta,proto3" json:"delta,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *VersionedReportContentResponse) Reset()      { *m = VersionedReportContentResponse{} }
+func (*VersionedReportContentResponse) ProtoMessage() {}
+func (*VersionedReportContentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78941759e4c5eff9, []int{7}
+}
+func (m *VersionedReportContentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VersionedReportContentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VersionedReportContentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VersionedReportContentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VersionedReportContentResponse.Merge(m, src)
+}
+func (m *VersionedReportContentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *VersionedReportContentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VersionedReportContentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VersionedReportContentResponse proto.InternalMessageInfo
+
+func (m *VersionedReportContentResponse) GetResourceVersion() uint64 {
+	if m != nil {
+		return m.ResourceVersion
+	}
+	return 0
+}
+
+func (m *VersionedReportContentResponse) GetType() ResponseType {
+	if m != nil {
+		return m.Type
+	}
+	return ResponseType_Snapshot
+}
+
+func (m *VersionedReportContentResponse) GetContent() []*ReportContent {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+func (m *VersionedReportContentResponse) GetDelta() []*ReportContentDelta {
+	if m != nil {
+		return m.Delta
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("reporterplugin.v1alpha1.FieldType", FieldType_name, FieldType_value)
+	proto.RegisterEnum("reporterplugin.v1alpha1.ResponseType", ResponseType_name, ResponseType_value)
+	proto.RegisterEnum("reporterplugin.v1alpha1.FieldOperation", FieldOperation_name, FieldOperation_value)
 	proto.RegisterType((*Empty)(nil), "reporterplugin.v1alpha1.Empty")
+	proto.RegisterType((*ObjectReference)(nil), "reporterplugin.v1alpha1.ObjectReference")
 	proto.RegisterType((*ReportContent)(nil), "reporterplugin.v1alpha1.ReportContent")
 	proto.RegisterType((*ReportField)(nil), "reporterplugin.v1alpha1.ReportField")
 	proto.RegisterType((*GetReportContentResponse)(nil), "reporterplugin.v1alpha1.GetReportContentResponse")
+	proto.RegisterType((*ReportFieldDelta)(nil), "reporterplugin.v1alpha1.ReportFieldDelta")
+	proto.RegisterType((*ReportContentDelta)(nil), "reporterplugin.v1alpha1.ReportContentDelta")
+	proto.RegisterType((*VersionedReportContentResponse)(nil), "reporterplugin.v1alpha1.VersionedReportContentResponse")
 }
 
 func init() { proto.RegisterFile("v1alpha1/api.proto", fileDescriptor_78941759e4c5eff9) }
 
 var fileDescriptor_78941759e4c5eff9 = []byte{
-	// 466 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x52, 0xcf, 0x6a, 0xd4, 0x40,
-	0x18, 0xdf, 0xd9, 0xba, 0x6d, 0xf7, 0xdb, 0x5a, 0x96, 0x41, 0x30, 0x2e, 0x12, 0x96, 0x20, 0x12,
-	0x04, 0x27, 0x66, 0x15, 0x11, 0x4f, 0x55, 0xb1, 0x3d, 0xf8, 0x07, 0x49, 0x45, 0x41, 0xbc, 0xcc,
-	0x26, 0x5f, 0xb3, 0x61, 0x37, 0x33, 0xc3, 0x64, 0xb2, 0xb0, 0x37, 0xc1, 0x17, 0xf0, 0x4d, 0x7c,
-	0x8d, 0x1e, 0x3d, 0x7a, 0xb4, 0xeb, 0xd9, 0x77, 0x90, 0x4c, 0x1a, 0x5b, 0x2b, 0xc1, 0x53, 0x6f,
-	0xf3, 0xfd, 0xe6, 0xf7, 0xef, 0x1b, 0x06, 0xe8, 0x32, 0xe4, 0x0b, 0x35, 0xe3, 0x61, 0xc0, 0x55,
-	0xc6, 0x94, 0x96, 0x46, 0xd2, 0xeb, 0x1a, 0x95, 0xd4, 0x06, 0xb5, 0x5a, 0x94, 0x69, 0x26, 0x58,
-	0x43, 0x19, 0xdd, 0x4d, 0x33, 0x33, 0x2b, 0xa7, 0x2c, 0x96, 0x79, 0x90, 0xca, 0x54, 0x06, 0x96,
-	0x3f, 0x2d, 0x8f, 0xec, 0x64, 0x07, 0x7b, 0xaa, 0x7d, 0x46, 0x0f, 0xe6, 0x8f, 0x0a, 0x96, 0xc9,
-	0xca, 0x39, 0xe7, 0xf1, 0x2c, 0x13, 0xa8, 0x57, 0x81, 0x9a, 0xa7, 0x15, 0x50, 0x04, 0x39, 0x1a,
-	0x1e, 0x2c, 0xc3, 0x20, 0x45, 0x81, 0x9a, 0x1b, 0x4c, 0x6a, 0x95, 0xb7, 0x05, 0xbd, 0xe7, 0xb9,
-	0x32, 0x2b, 0xef, 0x2b, 0x81, 0xab, 0x91, 0x6d, 0xf2, 0x4c, 0x0a, 0x83, 0xc2, 0xd0, 0x29, 0x0c,
-	0x53, 0x2d, 0x4b, 0xf5, 0x0e, 0x75, 0x91, 0x49, 0xf1, 0x22, 0x13, 0x89, 0x43, 0xc6, 0xc4, 0x1f,
-	0x4c, 0x1e, 0xb2, 0x3a, 0x8b, 0x9d, 0xcf, 0x62, 0x6a, 0x9e, 0x56, 0x40, 0xc1, 0xaa, 0x2c, 0xb6,
-	0x0c, 0xd9, 0xc1, 0x05, 0x75, 0xf4, 0x8f, 0x1f, 0x7d, 0x0c, 0xbd, 0xa3, 0x0c, 0x17, 0x89, 0xd3,
-	0x1d, 0x6f, 0xf8, 0x83, 0xc9, 0x2d, 0xd6, 0xf2, 0x18, 0xac, 0xae, 0xb6, 0x5f, 0x71, 0xa3, 0x5a,
-	0xe2, 0x7d, 0x26, 0x30, 0x38, 0x07, 0xd3, 0x3d, 0xe8, 0xdb, 0x8b, 0xb7, 0x2b, 0x85, 0xb6, 0xe8,
-	0xee, 0xc4, 0x6b, 0xf5, 0xdb, 0x6f, 0x98, 0xd1, 0x99, 0x88, 0xde, 0x3c, 0x75, 0x78, 0xcd, 0x73,
-	0x74, 0xba, 0x63, 0xe2, 0xf7, 0xa3, 0x33, 0x80, 0x5e, 0x83, 0xde, 0x92, 0x2f, 0x4a, 0x74, 0x36,
-	0xc6, 0xc4, 0xdf, 0x89, 0xea, 0xc1, 0xfb, 0x08, 0xce, 0x01, 0x9a, 0xbf, 0x5e, 0x2e, 0xc2, 0x42,
-	0x49, 0x51, 0x20, 0xdd, 0x83, 0xad, 0xb8, 0x86, 0x1c, 0x62, 0xf7, 0xbb, 0xfd, 0x9f, 0xfd, 0x1a,
-	0x83, 0x46, 0x76, 0x27, 0x80, 0xfe, 0x9f, 0xa6, 0x74, 0x1b, 0xae, 0x1c, 0x2a, 0x8c, 0x87, 0x1d,
-	0x0a, 0xb0, 0x79, 0x68, 0xb8, 0x29, 0x8b, 0x21, 0xa1, 0x3b, 0xb0, 0xfd, 0x0a, 0x0d, 0x4f, 0xb8,
-	0xe1, 0xc3, 0xee, 0xe4, 0x17, 0x81, 0xdd, 0xe8, 0x34, 0xe3, 0x8d, 0xcd, 0xa0, 0x29, 0x0c, 0x2f,
-	0x36, 0xa4, 0x6e, 0x6b, 0x11, 0xfb, 0x1b, 0x46, 0x61, 0xeb, 0x7d, 0xdb, 0xb2, 0x5e, 0x87, 0x6a,
-	0xb8, 0xf1, 0x32, 0x2b, 0xcc, 0x13, 0x91, 0xbc, 0xe7, 0x26, 0x9e, 0x5d, 0x7e, 0xe2, 0x3d, 0xf2,
-	0xd4, 0x3f, 0x3e, 0x71, 0xc9, 0xf7, 0x13, 0xb7, 0xf3, 0x69, 0xed, 0x92, 0xe3, 0xb5, 0x4b, 0xbe,
-	0xad, 0x5d, 0xf2, 0x63, 0xed, 0x92, 0x2f, 0x3f, 0xdd, 0xce, 0x07, 0x60, 0x41, 0x63, 0x33, 0xdd,
-	0xb4, 0x1f, 0xfe, 0xfe, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x4f, 0x1a, 0x4c, 0x25, 0x84, 0x03,
-	0x00, 0x00,
+	// 712 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0xcd, 0x38, 0x49, 0xdb, 0xdc, 0x96, 0xd6, 0x1a, 0x21, 0x11, 0x2a, 0x64, 0x55, 0x16, 0xd0,
+	0x50, 0x84, 0x4d, 0x02, 0xe2, 0xb5, 0xa1, 0xa5, 0xaf, 0x05, 0x8f, 0x22, 0x97, 0x87, 0x84, 0xd8,
+	0x4c, 0xec, 0x5b, 0xc7, 0x34, 0xf1, 0x8c, 0xec, 0x49, 0xa4, 0xee, 0x40, 0xdd, 0x21, 0x21, 0xb1,
+	0xe1, 0x9f, 0xba, 0x64, 0xc9, 0x92, 0x86, 0x2f, 0xe0, 0x0f, 0x90, 0xc7, 0x09, 0x79, 0x50, 0xb7,
+	0x05, 0xa9, 0xec, 0x3c, 0x77, 0xee, 0x39, 0x73, 0xce, 0x9d, 0x33, 0x09, 0xd0, 0x4e, 0x95, 0x35,
+	0x45, 0x83, 0x55, 0x6d, 0x26, 0x02, 0x4b, 0x44, 0x5c, 0x72, 0x7a, 0x21, 0x42, 0xc1, 0x23, 0x89,
+	0x91, 0x68, 0xb6, 0xfd, 0x20, 0xb4, 0xfa, 0x2d, 0xf3, 0x37, 0xfc, 0x40, 0x36, 0xda, 0x75, 0xcb,
+	0xe5, 0x2d, 0xdb, 0xe7, 0x3e, 0xb7, 0x55, 0x7f, 0xbd, 0xbd, 0xa3, 0x56, 0x6a, 0xa1, 0xbe, 0x52,
+	0x9e, 0xf9, 0xdb, 0xbb, 0xf7, 0x62, 0x2b, 0xe0, 0x09, 0x73, 0x8b, 0xb9, 0x8d, 0x20, 0xc4, 0x68,
+	0xcf, 0x16, 0xbb, 0x7e, 0x52, 0x88, 0xed, 0x16, 0x4a, 0x66, 0x77, 0xaa, 0xb6, 0x8f, 0x21, 0x46,
+	0x4c, 0xa2, 0x97, 0xa2, 0xcc, 0x49, 0x28, 0xae, 0xb7, 0x84, 0xdc, 0x33, 0x57, 0x61, 0x6e, 0xab,
+	0xfe, 0x0e, 0x5d, 0xe9, 0xe0, 0x0e, 0x46, 0x18, 0xba, 0x48, 0x2f, 0x41, 0x29, 0x64, 0x2d, 0x8c,
+	0x05, 0x73, 0xb1, 0x4c, 0x16, 0x48, 0xa5, 0xe4, 0x0c, 0x0a, 0x94, 0x42, 0x21, 0x59, 0x94, 0x35,
+	0xb5, 0xa1, 0xbe, 0xcd, 0x7d, 0x0d, 0xce, 0x39, 0xca, 0xce, 0x2a, 0x0f, 0x25, 0x86, 0x92, 0xd6,
+	0x41, 0xf7, 0x23, 0xde, 0x16, 0xaf, 0x30, 0x8a, 0x03, 0x1e, 0x3e, 0x0e, 0x42, 0x4f, 0x51, 0x4d,
+	0xd7, 0xee, 0x58, 0xa9, 0x60, 0x6b, 0x58, 0xb0, 0x25, 0x76, 0xfd, 0xa4, 0x10, 0x5b, 0x89, 0x60,
+	0xab, 0x53, 0xb5, 0x36, 0xc7, 0xd0, 0xce, 0x1f, 0x7c, 0xf4, 0x01, 0x14, 0x77, 0x02, 0x6c, 0x7a,
+	0x65, 0x6d, 0x21, 0x5f, 0x99, 0xae, 0x5d, 0xb6, 0x32, 0x26, 0x6a, 0xa5, 0xd2, 0x36, 0x92, 0x5e,
+	0x27, 0x85, 0x50, 0x07, 0xe6, 0xf8, 0xa8, 0xed, 0x72, 0x5e, 0xc9, 0xab, 0x64, 0xb2, 0x8c, 0x8d,
+	0xc9, 0x19, 0x27, 0x30, 0xf7, 0x09, 0x4c, 0x0f, 0x1d, 0x45, 0x97, 0xa1, 0xa4, 0x0e, 0x7b, 0xb1,
+	0x27, 0xd2, 0x39, 0xce, 0xd6, 0xcc, 0x4c, 0xf6, 0x8d, 0x7e, 0xa7, 0x33, 0x00, 0x25, 0x37, 0xa1,
+	0x16, 0xcf, 0x06, 0x03, 0x1f, 0x14, 0xe8, 0x79, 0x28, 0x76, 0x58, 0xb3, 0x9d, 0x2a, 0x9f, 0x71,
+	0xd2, 0x85, 0xf9, 0x16, 0xca, 0x9b, 0x28, 0x47, 0x6e, 0xc3, 0xc1, 0x58, 0xf0, 0x30, 0x46, 0xba,
+	0x0c, 0x93, 0x6e, 0x5a, 0x2a, 0x13, 0x35, 0xb3, 0xab, 0x27, 0xcc, 0xac, 0x4f, 0xd0, 0x87, 0x99,
+	0x5f, 0x08, 0xe8, 0x43, 0x1e, 0xd7, 0xb0, 0x29, 0x19, 0x5d, 0x87, 0x12, 0x17, 0x49, 0xbc, 0x02,
+	0x1e, 0xf6, 0x8c, 0x2e, 0x1e, 0x6f, 0x74, 0xab, 0xdf, 0xee, 0x0c, 0x90, 0xc3, 0xf7, 0x49, 0xfe,
+	0xf2, 0x3e, 0xcd, 0x4f, 0x1a, 0xd0, 0x11, 0xc9, 0xa9, 0xb2, 0xff, 0x11, 0xc3, 0x87, 0xa3, 0x31,
+	0xbc, 0x76, 0x1a, 0xd9, 0x4a, 0xdd, 0x59, 0x66, 0xf1, 0xa3, 0x06, 0x46, 0x4f, 0x24, 0x7a, 0x47,
+	0x87, 0xa1, 0x02, 0x73, 0x11, 0xc6, 0xbc, 0x1d, 0xb9, 0xd8, 0xeb, 0x54, 0xa3, 0x29, 0x38, 0xe3,
+	0x65, 0x7a, 0x1f, 0x0a, 0x32, 0xc9, 0xb0, 0xa6, 0xae, 0xf6, 0xca, 0x31, 0x06, 0x53, 0x6a, 0x15,
+	0x63, 0x05, 0x19, 0x4e, 0x5c, 0xfe, 0x9f, 0x12, 0x47, 0x57, 0xa0, 0xe8, 0x25, 0xd3, 0x2a, 0x17,
+	0x14, 0xfe, 0xfa, 0xe9, 0xf0, 0xbd, 0x01, 0x2b, 0xe4, 0x92, 0x0d, 0xa5, 0xdf, 0xcf, 0x8b, 0x4e,
+	0x41, 0x61, 0x5b, 0xa0, 0xab, 0xe7, 0x28, 0xc0, 0xc4, 0xb6, 0x64, 0xb2, 0x1d, 0xeb, 0x84, 0xce,
+	0xc0, 0xd4, 0x53, 0x94, 0xcc, 0x63, 0x92, 0xe9, 0xda, 0xd2, 0x22, 0xcc, 0x0c, 0x7b, 0x49, 0x76,
+	0xb7, 0x43, 0x26, 0xe2, 0x06, 0x97, 0x7a, 0x8e, 0x96, 0xa0, 0xa8, 0xe8, 0x75, 0xb2, 0x54, 0x85,
+	0xd9, 0xd1, 0x3c, 0xd3, 0x49, 0xc8, 0xaf, 0x78, 0x5e, 0xca, 0xfe, 0x52, 0x78, 0x4c, 0xa2, 0x4e,
+	0x92, 0xef, 0x35, 0x6c, 0xa2, 0x44, 0x5d, 0xab, 0xfd, 0xd4, 0x60, 0xd6, 0xe9, 0x59, 0x78, 0xae,
+	0x2c, 0x50, 0x1f, 0xf4, 0xf1, 0x27, 0x4b, 0x8d, 0x4c, 0x9f, 0xea, 0x77, 0x7b, 0xbe, 0x9a, 0xb9,
+	0x9f, 0xf5, 0xfa, 0xcd, 0x1c, 0x8d, 0xe0, 0xe2, 0x93, 0x20, 0x96, 0x2b, 0xa1, 0xf7, 0x9a, 0x49,
+	0xb7, 0x71, 0xf6, 0x27, 0xde, 0x24, 0xf4, 0x03, 0x01, 0x73, 0xf8, 0xd0, 0xa3, 0x53, 0x79, 0xe2,
+	0xe9, 0x77, 0x33, 0xf7, 0x8f, 0x8f, 0x79, 0xa2, 0xe1, 0x51, 0xe5, 0xe0, 0xd0, 0x20, 0xdf, 0x0e,
+	0x8d, 0xdc, 0xfb, 0xae, 0x41, 0x0e, 0xba, 0x06, 0xf9, 0xda, 0x35, 0xc8, 0xf7, 0xae, 0x41, 0x3e,
+	0xff, 0x30, 0x72, 0x6f, 0xc0, 0xb2, 0xfb, 0x64, 0xf5, 0x09, 0xf5, 0xf7, 0x78, 0xeb, 0xd7, 0x00,
+	0x79, 0xa4, 0xa9, 0x13, 0xb2, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -328,6 +646,7 @@ const _ = grpc.SupportPackageIsVersion4
 type ReporterPluginClient interface {
 	GetReportContent(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetReportContentResponse, error)
 	ListAndWatchReportContent(ctx context.Context, in *Empty, opts ...grpc.CallOption) (ReporterPlugin_ListAndWatchReportContentClient, error)
+	ListAndWatchVersionedReportContent(ctx context.Context, in *Empty, opts ...grpc.CallOption) (ReporterPlugin_ListAndWatchVersionedReportContentClient, error)
 }
 
 type reporterPluginClient struct {
@@ -379,10 +698,43 @@ func (x *reporterPluginListAndWatchReportContentClient) Recv() (*GetReportConten
 	return m, nil
 }
 
+func (c *reporterPluginClient) ListAndWatchVersionedReportContent(ctx context.Context, in *Empty, opts ...grpc.CallOption) (ReporterPlugin_ListAndWatchVersionedReportContentClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ReporterPlugin_serviceDesc.Streams[1], "/reporterplugin.v1alpha1.ReporterPlugin/ListAndWatchVersionedReportContent", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &reporterPluginListAndWatchVersionedReportContentClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ReporterPlugin_ListAndWatchVersionedReportContentClient interface {
+	Recv() (*VersionedReportContentResponse, error)
+	grpc.ClientStream
+}
+
+type reporterPluginListAndWatchVersionedReportContentClient struct {
+	grpc.ClientStream
+}
+
+func (x *reporterPluginListAndWatchVersionedReportContentClient) Recv() (*VersionedReportContentResponse, error) {
+	m := new(VersionedReportContentResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ReporterPluginServer is the server API for ReporterPlugin service.
 type ReporterPluginServer interface {
 	GetReportContent(context.Context, *Empty) (*GetReportContentResponse, error)
 	ListAndWatchReportContent(*Empty, ReporterPlugin_ListAndWatchReportContentServer) error
+	ListAndWatchVersionedReportContent(*Empty, ReporterPlugin_ListAndWatchVersionedReportContentServer) error
 }
 
 // UnimplementedReporterPluginServer can be embedded to have forward compatible implementations.
@@ -395,6 +747,9 @@ func (*UnimplementedReporterPluginServer) GetReportContent(ctx context.Context,
 func (*UnimplementedReporterPluginServer) ListAndWatchReportContent(req *Empty, srv ReporterPlugin_ListAndWatchReportContentServer) error {
 	return status.Errorf(codes.Unimplemented, "method ListAndWatchReportContent not implemented")
 }
+func (*UnimplementedReporterPluginServer) ListAndWatchVersionedReportContent(req *Empty, srv ReporterPlugin_ListAndWatchVersionedReportContentServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListAndWatchVersionedReportContent not implemented")
+}
 
 func RegisterReporterPluginServer(s *grpc.Server, srv ReporterPluginServer) {
 	s.RegisterService(&_ReporterPlugin_serviceDesc, srv)
@@ -439,6 +794,27 @@ func (x *reporterPluginListAndWatchReportContentServer) Send(m *GetReportContent
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ReporterPlugin_ListAndWatchVersionedReportContent_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ReporterPluginServer).ListAndWatchVersionedReportContent(m, &reporterPluginListAndWatchVersionedReportContentServer{stream})
+}
+
+type ReporterPlugin_ListAndWatchVersionedReportContentServer interface {
+	Send(*VersionedReportContentResponse) error
+	grpc.ServerStream
+}
+
+type reporterPluginListAndWatchVersionedReportContentServer struct {
+	grpc.ServerStream
+}
+
+func (x *reporterPluginListAndWatchVersionedReportContentServer) Send(m *VersionedReportContentResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _ReporterPlugin_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "reporterplugin.v1alpha1.ReporterPlugin",
 	HandlerType: (*ReporterPluginServer)(nil),
@@ -454,6 +830,11 @@ var _ReporterPlugin_serviceDesc = grpc.ServiceDesc{
 			Handler:       _ReporterPlugin_ListAndWatchReportContent_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ListAndWatchVersionedReportContent",
+			Handler:       _ReporterPlugin_ListAndWatchVersionedReportContent_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "v1alpha1/api.proto",
 }
@@ -481,7 +862,7 @@ func (m *Empty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ReportContent) Marshal() (dAtA []byte, err error) {
+func (m *ObjectReference) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -491,46 +872,34 @@ func (m *ReportContent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ReportContent) MarshalTo(dAtA []byte) (int, error) {
+func (m *ObjectReference) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ReportContent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ObjectReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Field) > 0 {
-		for iNdEx := len(m.Field) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Field[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintApi(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.GroupVersionKind != nil {
-		{
-			size, err := m.GroupVersionKind.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintApi(dAtA, i, uint64(size))
-		}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Namespace)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ReportField) Marshal() (dAtA []byte, err error) {
+func (m *ReportContent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -540,7 +909,68 @@ func (m *ReportField) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ReportField) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReportContent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReportContent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ObjectReference != nil {
+		{
+			size, err := m.ObjectReference.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Field) > 0 {
+		for iNdEx := len(m.Field) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Field[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.GroupVersionKind != nil {
+		{
+			size, err := m.GroupVersionKind.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReportField) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReportField) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
@@ -609,6 +1039,168 @@ func (m *GetReportContentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *ReportFieldDelta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReportFieldDelta) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReportFieldDelta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Field != nil {
+		{
+			size, err := m.Field.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Operation != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.Operation))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReportContentDelta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReportContentDelta) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReportContentDelta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ObjectReference != nil {
+		{
+			size, err := m.ObjectReference.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Field) > 0 {
+		for iNdEx := len(m.Field) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Field[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.GroupVersionKind != nil {
+		{
+			size, err := m.GroupVersionKind.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VersionedReportContentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VersionedReportContentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VersionedReportContentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delta) > 0 {
+		for iNdEx := len(m.Delta) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delta[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Content) > 0 {
+		for iNdEx := len(m.Content) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Content[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Type != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ResourceVersion != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.ResourceVersion))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintApi(dAtA []byte, offset int, v uint64) int {
 	offset -= sovApi(v)
 	base := offset
@@ -629,6 +1221,23 @@ func (m *Empty) Size() (n int) {
 	return n
 }
 
+func (m *ObjectReference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
 func (m *ReportContent) Size() (n int) {
 	if m == nil {
 		return 0
@@ -645,6 +1254,10 @@ func (m *ReportContent) Size() (n int) {
 			n += 1 + l + sovApi(uint64(l))
 		}
 	}
+	if m.ObjectReference != nil {
+		l = m.ObjectReference.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
 	return n
 }
 
@@ -683,46 +1296,124 @@ func (m *GetReportContentResponse) Size() (n int) {
 	return n
 }
 
-func sovApi(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozApi(x uint64) (n int) {
-	return sovApi(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *Empty) String() string {
-	if this == nil {
-		return "nil"
+func (m *ReportFieldDelta) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&Empty{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ReportContent) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.Operation != 0 {
+		n += 1 + sovApi(uint64(m.Operation))
 	}
-	repeatedStringForField := "[]*ReportField{"
-	for _, f := range this.Field {
-		repeatedStringForField += strings.Replace(f.String(), "ReportField", "ReportField", 1) + ","
+	if m.Field != nil {
+		l = m.Field.Size()
+		n += 1 + l + sovApi(uint64(l))
 	}
-	repeatedStringForField += "}"
-	s := strings.Join([]string{`&ReportContent{`,
-		`GroupVersionKind:` + strings.Replace(fmt.Sprintf("%v", this.GroupVersionKind), "GroupVersionKind", "v1.GroupVersionKind", 1) + `,`,
-		`Field:` + repeatedStringForField + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ReportField) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ReportContentDelta) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ReportField{`,
-		`FieldType:` + fmt.Sprintf("%v", this.FieldType) + `,`,
-		`FieldName:` + fmt.Sprintf("%v", this.FieldName) + `,`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`}`,
+	var l int
+	_ = l
+	if m.GroupVersionKind != nil {
+		l = m.GroupVersionKind.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if len(m.Field) > 0 {
+		for _, e := range m.Field {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	if m.ObjectReference != nil {
+		l = m.ObjectReference.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *VersionedReportContentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ResourceVersion != 0 {
+		n += 1 + sovApi(uint64(m.ResourceVersion))
+	}
+	if m.Type != 0 {
+		n += 1 + sovApi(uint64(m.Type))
+	}
+	if len(m.Content) > 0 {
+		for _, e := range m.Content {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	if len(m.Delta) > 0 {
+		for _, e := range m.Delta {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovApi(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozApi(x uint64) (n int) {
+	return sovApi(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *Empty) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Empty{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ObjectReference) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ObjectReference{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReportContent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForField := "[]*ReportField{"
+	for _, f := range this.Field {
+		repeatedStringForField += strings.Replace(f.String(), "ReportField", "ReportField", 1) + ","
+	}
+	repeatedStringForField += "}"
+	s := strings.Join([]string{`&ReportContent{`,
+		`GroupVersionKind:` + strings.Replace(fmt.Sprintf("%v", this.GroupVersionKind), "GroupVersionKind", "v1.GroupVersionKind", 1) + `,`,
+		`Field:` + repeatedStringForField + `,`,
+		`ObjectReference:` + strings.Replace(this.ObjectReference.String(), "ObjectReference", "ObjectReference", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReportField) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReportField{`,
+		`FieldType:` + fmt.Sprintf("%v", this.FieldType) + `,`,
+		`FieldName:` + fmt.Sprintf("%v", this.FieldName) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
 	}, "")
 	return s
 }
@@ -734,22 +1425,540 @@ func (this *GetReportContentResponse) String() string {
 	for _, f := range this.Content {
 		repeatedStringForContent += strings.Replace(f.String(), "ReportContent", "ReportContent", 1) + ","
 	}
-	repeatedStringForContent += "}"
-	s := strings.Join([]string{`&GetReportContentResponse{`,
-		`Content:` + repeatedStringForContent + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringApi(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	repeatedStringForContent += "}"
+	s := strings.Join([]string{`&GetReportContentResponse{`,
+		`Content:` + repeatedStringForContent + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReportFieldDelta) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReportFieldDelta{`,
+		`Operation:` + fmt.Sprintf("%v", this.Operation) + `,`,
+		`Field:` + strings.Replace(this.Field.String(), "ReportField", "ReportField", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReportContentDelta) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForField := "[]*ReportFieldDelta{"
+	for _, f := range this.Field {
+		repeatedStringForField += strings.Replace(f.String(), "ReportFieldDelta", "ReportFieldDelta", 1) + ","
+	}
+	repeatedStringForField += "}"
+	s := strings.Join([]string{`&ReportContentDelta{`,
+		`GroupVersionKind:` + strings.Replace(fmt.Sprintf("%v", this.GroupVersionKind), "GroupVersionKind", "v1.GroupVersionKind", 1) + `,`,
+		`Field:` + repeatedStringForField + `,`,
+		`ObjectReference:` + strings.Replace(this.ObjectReference.String(), "ObjectReference", "ObjectReference", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VersionedReportContentResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForContent := "[]*ReportContent{"
+	for _, f := range this.Content {
+		repeatedStringForContent += strings.Replace(f.String(), "ReportContent", "ReportContent", 1) + ","
+	}
+	repeatedStringForContent += "}"
+	repeatedStringForDelta := "[]*ReportContentDelta{"
+	for _, f := range this.Delta {
+		repeatedStringForDelta += strings.Replace(f.String(), "ReportContentDelta", "ReportContentDelta", 1) + ","
+	}
+	repeatedStringForDelta += "}"
+	s := strings.Join([]string{`&VersionedReportContentResponse{`,
+		`ResourceVersion:` + fmt.Sprintf("%v", this.ResourceVersion) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Content:` + repeatedStringForContent + `,`,
+		`Delta:` + repeatedStringForDelta + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringApi(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *Empty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Empty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Empty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ObjectReference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObjectReference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObjectReference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReportContent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReportContent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReportContent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupVersionKind", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GroupVersionKind == nil {
+				m.GroupVersionKind = &v1.GroupVersionKind{}
+			}
+			if err := m.GroupVersionKind.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = append(m.Field, &ReportField{})
+			if err := m.Field[len(m.Field)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectReference", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ObjectReference == nil {
+				m.ObjectReference = &ObjectReference{}
+			}
+			if err := m.ObjectReference.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReportField) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReportField: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReportField: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldType", wireType)
+			}
+			m.FieldType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FieldType |= FieldType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FieldName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *Empty) Unmarshal(dAtA []byte) error {
+func (m *GetReportContentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -772,12 +1981,46 @@ func (m *Empty) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Empty: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetReportContentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Empty: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetReportContentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Content = append(m.Content, &ReportContent{})
+			if err := m.Content[len(m.Content)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -802,7 +2045,7 @@ func (m *Empty) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReportContent) Unmarshal(dAtA []byte) error {
+func (m *ReportFieldDelta) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -825,17 +2068,17 @@ func (m *ReportContent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReportContent: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReportFieldDelta: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReportContent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReportFieldDelta: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupVersionKind", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
 			}
-			var msglen int
+			m.Operation = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -845,28 +2088,11 @@ func (m *ReportContent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Operation |= FieldOperation(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthApi
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.GroupVersionKind == nil {
-				m.GroupVersionKind = &v1.GroupVersionKind{}
-			}
-			if err := m.GroupVersionKind.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
@@ -896,8 +2122,10 @@ func (m *ReportContent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Field = append(m.Field, &ReportField{})
-			if err := m.Field[len(m.Field)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Field == nil {
+				m.Field = &ReportField{}
+			}
+			if err := m.Field.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -925,7 +2153,7 @@ func (m *ReportContent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReportField) Unmarshal(dAtA []byte) error {
+func (m *ReportContentDelta) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -948,17 +2176,17 @@ func (m *ReportField) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReportField: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReportContentDelta: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReportField: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReportContentDelta: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FieldType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupVersionKind", wireType)
 			}
-			m.FieldType = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -968,16 +2196,33 @@ func (m *ReportField) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FieldType |= FieldType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GroupVersionKind == nil {
+				m.GroupVersionKind = &v1.GroupVersionKind{}
+			}
+			if err := m.GroupVersionKind.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FieldName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -987,29 +2232,31 @@ func (m *ReportField) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FieldName = string(dAtA[iNdEx:postIndex])
+			m.Field = append(m.Field, &ReportFieldDelta{})
+			if err := m.Field[len(m.Field)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectReference", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -1019,24 +2266,26 @@ func (m *ReportField) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
+			if m.ObjectReference == nil {
+				m.ObjectReference = &ObjectReference{}
+			}
+			if err := m.ObjectReference.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -1063,7 +2312,7 @@ func (m *ReportField) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetReportContentResponse) Unmarshal(dAtA []byte) error {
+func (m *VersionedReportContentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1086,13 +2335,51 @@ func (m *GetReportContentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetReportContentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: VersionedReportContentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetReportContentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VersionedReportContentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
+			}
+			m.ResourceVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResourceVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ResponseType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
 			}
@@ -1126,6 +2413,40 @@ func (m *GetReportContentResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delta = append(m.Delta, &ReportContentDelta{})
+			if err := m.Delta[len(m.Delta)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -0,0 +1,131 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	nodev1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/node/v1alpha1"
+)
+
+// SetCNRCondition sets the condition in conditions, or adds it if the type doesn't exist yet;
+// LastHeartbeatTime is set to now if it's not specified. It returns whether status, reason
+// or message of the condition is changed.
+func SetCNRCondition(conditions *[]nodev1alpha1.CNRCondition, newCondition nodev1alpha1.CNRCondition) bool {
+	if conditions == nil {
+		return false
+	}
+
+	if newCondition.LastHeartbeatTime.IsZero() {
+		newCondition.LastHeartbeatTime = metav1.NewTime(time.Now())
+	}
+
+	existing := GetCNRCondition(*conditions, newCondition.Type)
+	if existing == nil {
+		*conditions = append(*conditions, newCondition)
+		return true
+	}
+
+	changed := existing.Status != newCondition.Status || existing.Reason != newCondition.Reason ||
+		existing.Message != newCondition.Message
+	*existing = newCondition
+	return changed
+}
+
+// RefreshCNRConditionHeartbeat updates LastHeartbeatTime of the condition to now, and returns
+// false if the condition doesn't exist.
+func RefreshCNRConditionHeartbeat(conditions []nodev1alpha1.CNRCondition, conditionType nodev1alpha1.CNRConditionType, now time.Time) bool {
+	condition := GetCNRCondition(conditions, conditionType)
+	if condition == nil {
+		return false
+	}
+
+	condition.LastHeartbeatTime = metav1.NewTime(now)
+	return true
+}
+
+// RemoveCNRCondition removes the condition of the type, and returns whether it existed.
+func RemoveCNRCondition(conditions *[]nodev1alpha1.CNRCondition, conditionType nodev1alpha1.CNRConditionType) bool {
+	if conditions == nil || len(*conditions) == 0 {
+		return false
+	}
+
+	newConditions := make([]nodev1alpha1.CNRCondition, 0, len(*conditions)-1)
+	for _, condition := range *conditions {
+		if condition.Type != conditionType {
+			newConditions = append(newConditions, condition)
+		}
+	}
+
+	removed := len(*conditions) != len(newConditions)
+	*conditions = newConditions
+	return removed
+}
+
+// GetCNRCondition returns the condition of the type, or nil if it doesn't exist.
+func GetCNRCondition(conditions []nodev1alpha1.CNRCondition, conditionType nodev1alpha1.CNRConditionType) *nodev1alpha1.CNRCondition {
+	for i := range conditions {
+		if conditions[i].Type == conditionType {
+			return &conditions[i]
+		}
+	}
+	return nil
+}
+
+// IsCNRConditionTrue returns whether the condition of the type exists and its status is true.
+func IsCNRConditionTrue(conditions []nodev1alpha1.CNRCondition, conditionType nodev1alpha1.CNRConditionType) bool {
+	condition := GetCNRCondition(conditions, conditionType)
+	return condition != nil && condition.Status == v1.ConditionTrue
+}
+
+// IsCNRConditionFresh returns whether the condition of the type is true and its heartbeat
+// is within timeout before now.
+func IsCNRConditionFresh(conditions []nodev1alpha1.CNRCondition, conditionType nodev1alpha1.CNRConditionType,
+	now time.Time, timeout time.Duration,
+) bool {
+	condition := GetCNRCondition(conditions, conditionType)
+	return condition != nil && condition.Status == v1.ConditionTrue &&
+		!condition.LastHeartbeatTime.IsZero() && now.Sub(condition.LastHeartbeatTime.Time) <= timeout
+}
+
+// StaleCNRConditions returns the given condition types which are not fresh in the cnr, and
+// controllers should stop trusting the data reported along with them.
+func StaleCNRConditions(cnr *nodev1alpha1.CustomNodeResource, timeout time.Duration,
+	conditionTypes ...nodev1alpha1.CNRConditionType,
+) []nodev1alpha1.CNRConditionType {
+	now := time.Now()
+
+	var stale []nodev1alpha1.CNRConditionType
+	for _, conditionType := range conditionTypes {
+		if !IsCNRConditionFresh(cnr.Status.Conditions, conditionType, now, timeout) {
+			stale = append(stale, conditionType)
+		}
+	}
+	return stale
+}
+
+// IsCNRStale returns whether the agent of the cnr is not found, or it's not ready with
+// heartbeat within timeout.
+func IsCNRStale(cnr *nodev1alpha1.CustomNodeResource, timeout time.Duration) bool {
+	if cnr == nil || IsCNRConditionTrue(cnr.Status.Conditions, nodev1alpha1.CNRAgentNotFound) {
+		return true
+	}
+	return !IsCNRConditionFresh(cnr.Status.Conditions, nodev1alpha1.CNRAgentReady, time.Now(), timeout)
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+
+	jsonpatch "github.com/evanphx/json-patch"
+
+	nodev1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/node/v1alpha1"
+	"github.com/kubewharf/katalyst-api/pkg/apis/node/v1alpha1/topology"
+)
+
+// cnrStatusListFields are the paths of list fields in cnr status; json merge patch replaces
+// lists as a whole, so patches changing any of them are guarded by resource version.
+var cnrStatusListFields = [][]string{
+	{"topologyZone"},
+	{"conditions"},
+	{"resources", "resourcePackages"},
+	{"resources", "resourcePools"},
+	{"nodeMetricStatus", "nodeMetric", "numaUsage"},
+	// numaUsage of groups are replaced along with groupMetric
+	{"nodeMetricStatus", "groupMetric"},
+}
+
+// CNRStatusPatchBuilder builds json merge patch for the status of CustomNodeResource, since
+// strategic merge patch is not supported by custom resources. The patch only contains the
+// changed fields, so that writers of different fields won't clobber each other; and if any
+// list field is changed, resourceVersion of the original object is added as precondition,
+// so that concurrent writers of the same list will get conflict instead of overwriting.
+type CNRStatusPatchBuilder struct {
+	original *nodev1alpha1.CustomNodeResource
+	modified *nodev1alpha1.CustomNodeResourceStatus
+}
+
+// NewCNRStatusPatchBuilder returns a builder based on the current cnr, which is not modified by the builder.
+func NewCNRStatusPatchBuilder(cnr *nodev1alpha1.CustomNodeResource) *CNRStatusPatchBuilder {
+	return &CNRStatusPatchBuilder{
+		original: cnr,
+		modified: cnr.Status.DeepCopy(),
+	}
+}
+
+// Status returns the modified status, and callers can change it directly for the fields
+// that have no setter in the builder.
+func (b *CNRStatusPatchBuilder) Status() *nodev1alpha1.CustomNodeResourceStatus {
+	return b.modified
+}
+
+// SetResources replaces the resources of cnr status
+func (b *CNRStatusPatchBuilder) SetResources(resources nodev1alpha1.Resources) *CNRStatusPatchBuilder {
+	b.modified.Resources = *resources.DeepCopy()
+	return b
+}
+
+// SetTopologyZone replaces the topology zones of cnr status
+func (b *CNRStatusPatchBuilder) SetTopologyZone(zones []*nodev1alpha1.TopologyZone) *CNRStatusPatchBuilder {
+	b.modified.TopologyZone = make([]*nodev1alpha1.TopologyZone, 0, len(zones))
+	for _, zone := range zones {
+		b.modified.TopologyZone = append(b.modified.TopologyZone, zone.DeepCopy())
+	}
+	return b
+}
+
+// SetTopologyPolicy replaces the topology policy of cnr status
+func (b *CNRStatusPatchBuilder) SetTopologyPolicy(policy nodev1alpha1.TopologyPolicy) *CNRStatusPatchBuilder {
+	b.modified.TopologyPolicy = policy
+	return b
+}
+
+// SetNodeMetricStatus replaces the node metric status of cnr status
+func (b *CNRStatusPatchBuilder) SetNodeMetricStatus(status *nodev1alpha1.NodeMetricStatus) *CNRStatusPatchBuilder {
+	b.modified.NodeMetricStatus = status.DeepCopy()
+	return b
+}
+
+// SetCondition adds the condition or replaces the existing one with the same type
+func (b *CNRStatusPatchBuilder) SetCondition(condition nodev1alpha1.CNRCondition) *CNRStatusPatchBuilder {
+	for i := range b.modified.Conditions {
+		if b.modified.Conditions[i].Type == condition.Type {
+			b.modified.Conditions[i] = *condition.DeepCopy()
+			return b
+		}
+	}
+
+	b.modified.Conditions = append(b.modified.Conditions, *condition.DeepCopy())
+	return b
+}
+
+// Build returns the json merge patch to be applied to the status subresource, and returns
+// nil if nothing changed; topology zones are normalized before comparison, so changes of
+// order only are ignored.
+func (b *CNRStatusPatchBuilder) Build() ([]byte, error) {
+	original := b.original.Status.DeepCopy()
+	modified := b.modified.DeepCopy()
+	topology.NormalizeStatus(original)
+	topology.NormalizeStatus(modified)
+
+	originalData, err := json.Marshal(&nodev1alpha1.CustomNodeResource{Status: *original})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal original cnr status: %v", err)
+	}
+
+	modifiedData, err := json.Marshal(&nodev1alpha1.CustomNodeResource{Status: *modified})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal modified cnr status: %v", err)
+	}
+
+	patchData, err := jsonpatch.CreateMergePatch(originalData, modifiedData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create merge patch: %v", err)
+	}
+
+	patch := make(map[string]interface{})
+	if err := json.Unmarshal(patchData, &patch); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal merge patch: %v", err)
+	}
+
+	status, ok := patch["status"].(map[string]interface{})
+	if !ok || len(status) == 0 {
+		return nil, nil
+	}
+
+	// only status is patched, and the outer fields of an empty cnr (such as metadata
+	// with creationTimestamp: null) must not be included
+	patch = map[string]interface{}{"status": status}
+	if touchesCNRStatusListField(status) && b.original.ResourceVersion != "" {
+		patch["metadata"] = map[string]interface{}{"resourceVersion": b.original.ResourceVersion}
+	}
+
+	return json.Marshal(patch)
+}
+
+func touchesCNRStatusListField(status map[string]interface{}) bool {
+	for _, fieldPath := range cnrStatusListFields {
+		current := status
+		for i, name := range fieldPath {
+			value, ok := current[name]
+			if !ok {
+				break
+			}
+
+			if i == len(fieldPath)-1 {
+				return true
+			}
+
+			if current, ok = value.(map[string]interface{}); !ok {
+				break
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,270 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"sort"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	nodev1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/node/v1alpha1"
+	"github.com/kubewharf/katalyst-api/pkg/consts"
+)
+
+// resourceUsageBuilder accumulates generic and per-numa usage
+type resourceUsageBuilder struct {
+	generic v1.ResourceList
+	numa    map[int]v1.ResourceList
+}
+
+func newResourceUsageBuilder() *resourceUsageBuilder {
+	return &resourceUsageBuilder{
+		generic: v1.ResourceList{},
+		numa:    make(map[int]v1.ResourceList),
+	}
+}
+
+func (b *resourceUsageBuilder) addGeneric(usage v1.ResourceList) {
+	addResourceList(b.generic, usage)
+}
+
+func (b *resourceUsageBuilder) addNUMA(numaID int, usage v1.ResourceList) {
+	if b.numa[numaID] == nil {
+		b.numa[numaID] = v1.ResourceList{}
+	}
+	addResourceList(b.numa[numaID], usage)
+}
+
+func (b *resourceUsageBuilder) merge(other *resourceUsageBuilder) {
+	b.addGeneric(other.generic)
+	for numaID, usage := range other.numa {
+		b.addNUMA(numaID, usage)
+	}
+}
+
+func (b *resourceUsageBuilder) empty() bool {
+	return len(b.generic) == 0 && len(b.numa) == 0
+}
+
+// build returns the usage with numa sorted by id
+func (b *resourceUsageBuilder) build() nodev1alpha1.ResourceUsage {
+	usage := nodev1alpha1.ResourceUsage{}
+	if len(b.generic) > 0 {
+		usage.GenericUsage = b.generic.DeepCopy()
+	}
+
+	for numaID, numaUsage := range b.numa {
+		usage.NUMAUsage = append(usage.NUMAUsage, nodev1alpha1.NUMAMetricInfo{
+			NUMAId: numaID,
+			Usage:  numaUsage.DeepCopy(),
+		})
+	}
+	sort.Slice(usage.NUMAUsage, func(i, j int) bool {
+		return usage.NUMAUsage[i].NUMAId < usage.NUMAUsage[j].NUMAId
+	})
+	return usage
+}
+
+// groupMetricBuilder accumulates usage and pods of a qos level group
+type groupMetricBuilder struct {
+	*resourceUsageBuilder
+	pods map[string]bool
+}
+
+// NodeMetricStatusBuilder assembles NodeMetricStatus from samples of pods and numa nodes; samples
+// of the same pod, numa or group are summed up. If no node-level sample is added, usage of the node
+// is aggregated from all the groups.
+type NodeMetricStatusBuilder struct {
+	updateTime metav1.Time
+	node       *resourceUsageBuilder
+	groups     map[consts.QoSLevel]*groupMetricBuilder
+}
+
+// NewNodeMetricStatusBuilder returns a builder whose result is updated at the given time.
+func NewNodeMetricStatusBuilder(updateTime time.Time) *NodeMetricStatusBuilder {
+	return &NodeMetricStatusBuilder{
+		updateTime: metav1.NewTime(updateTime),
+		node:       newResourceUsageBuilder(),
+		groups:     make(map[consts.QoSLevel]*groupMetricBuilder),
+	}
+}
+
+// AddNodeUsage adds a sample of the whole node
+func (b *NodeMetricStatusBuilder) AddNodeUsage(usage v1.ResourceList) *NodeMetricStatusBuilder {
+	b.node.addGeneric(usage)
+	return b
+}
+
+// AddNodeNUMAUsage adds a sample of the numa node
+func (b *NodeMetricStatusBuilder) AddNodeNUMAUsage(numaID int, usage v1.ResourceList) *NodeMetricStatusBuilder {
+	b.node.addNUMA(numaID, usage)
+	return b
+}
+
+// AddPodUsage adds a sample of the pod to the group of its qos level, and lists the pod in the group.
+func (b *NodeMetricStatusBuilder) AddPodUsage(qosLevel consts.QoSLevel, namespace, name string, usage v1.ResourceList) *NodeMetricStatusBuilder {
+	group := b.group(qosLevel, namespace, name)
+	group.addGeneric(usage)
+	return b
+}
+
+// AddPodNUMAUsage adds a sample of the pod on the numa node to the group of its qos level, and lists the pod in the group.
+func (b *NodeMetricStatusBuilder) AddPodNUMAUsage(qosLevel consts.QoSLevel, namespace, name string, numaID int, usage v1.ResourceList) *NodeMetricStatusBuilder {
+	group := b.group(qosLevel, namespace, name)
+	group.addNUMA(numaID, usage)
+	return b
+}
+
+func (b *NodeMetricStatusBuilder) group(qosLevel consts.QoSLevel, namespace, name string) *groupMetricBuilder {
+	group, ok := b.groups[qosLevel]
+	if !ok {
+		group = &groupMetricBuilder{
+			resourceUsageBuilder: newResourceUsageBuilder(),
+			pods:                 make(map[string]bool),
+		}
+		b.groups[qosLevel] = group
+	}
+	group.pods[namespace+"/"+name] = true
+	return group
+}
+
+// Build returns the NodeMetricStatus, in which groups, numa nodes and pods are sorted.
+func (b *NodeMetricStatusBuilder) Build() *nodev1alpha1.NodeMetricStatus {
+	status := &nodev1alpha1.NodeMetricStatus{UpdateTime: b.updateTime}
+
+	node := b.node
+	if node.empty() {
+		node = newResourceUsageBuilder()
+		for _, group := range b.groups {
+			node.merge(group.resourceUsageBuilder)
+		}
+	}
+	if !node.empty() {
+		status.NodeMetric = &nodev1alpha1.NodeMetricInfo{ResourceUsage: node.build()}
+	}
+
+	for qosLevel, group := range b.groups {
+		info := nodev1alpha1.GroupMetricInfo{
+			QoSLevel:      string(qosLevel),
+			ResourceUsage: group.build(),
+		}
+		for pod := range group.pods {
+			info.PodList = append(info.PodList, pod)
+		}
+		sort.Strings(info.PodList)
+		status.GroupMetric = append(status.GroupMetric, info)
+	}
+	sort.Slice(status.GroupMetric, func(i, j int) bool {
+		return status.GroupMetric[i].QoSLevel < status.GroupMetric[j].QoSLevel
+	})
+	return status
+}
+
+// GetGroupMetric returns the group of the qos level, or nil if it's not found.
+func GetGroupMetric(status *nodev1alpha1.NodeMetricStatus, qosLevel consts.QoSLevel) *nodev1alpha1.GroupMetricInfo {
+	if status == nil {
+		return nil
+	}
+
+	for i := range status.GroupMetric {
+		if status.GroupMetric[i].QoSLevel == string(qosLevel) {
+			return &status.GroupMetric[i]
+		}
+	}
+	return nil
+}
+
+// GetNUMAUsage returns the usage of the numa node, or nil if it's not found.
+func GetNUMAUsage(usage *nodev1alpha1.ResourceUsage, numaID int) v1.ResourceList {
+	if usage == nil {
+		return nil
+	}
+
+	for _, info := range usage.NUMAUsage {
+		if info.NUMAId == numaID {
+			return info.Usage
+		}
+	}
+	return nil
+}
+
+// GetNodeNUMAResourceUsage returns the usage of the resource on the numa node for the whole node.
+func GetNodeNUMAResourceUsage(status *nodev1alpha1.NodeMetricStatus, numaID int, resourceName v1.ResourceName) (resource.Quantity, bool) {
+	if status == nil || status.NodeMetric == nil {
+		return resource.Quantity{}, false
+	}
+
+	quantity, ok := GetNUMAUsage(&status.NodeMetric.ResourceUsage, numaID)[resourceName]
+	return quantity, ok
+}
+
+// GetGroupNUMAResourceUsage returns the usage of the resource on the numa node for the qos level group,
+// e.g. the cpu usage of reclaimed_cores on numa 1.
+func GetGroupNUMAResourceUsage(status *nodev1alpha1.NodeMetricStatus, qosLevel consts.QoSLevel,
+	numaID int, resourceName v1.ResourceName,
+) (resource.Quantity, bool) {
+	group := GetGroupMetric(status, qosLevel)
+	if group == nil {
+		return resource.Quantity{}, false
+	}
+
+	quantity, ok := GetNUMAUsage(&group.ResourceUsage, numaID)[resourceName]
+	return quantity, ok
+}
+
+// GetGroupResourceUsage returns the generic usage of the resource for the qos level group.
+func GetGroupResourceUsage(status *nodev1alpha1.NodeMetricStatus, qosLevel consts.QoSLevel,
+	resourceName v1.ResourceName,
+) (resource.Quantity, bool) {
+	group := GetGroupMetric(status, qosLevel)
+	if group == nil {
+		return resource.Quantity{}, false
+	}
+
+	quantity, ok := group.GenericUsage[resourceName]
+	return quantity, ok
+}
+
+// IsPodInGroupMetric returns whether the pod is listed in any group, and pods not listed are
+// supposed to be estimated by the consumers.
+func IsPodInGroupMetric(status *nodev1alpha1.NodeMetricStatus, namespace, name string) bool {
+	if status == nil {
+		return false
+	}
+
+	key := namespace + "/" + name
+	for _, group := range status.GroupMetric {
+		for _, pod := range group.PodList {
+			if pod == key {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// IsNodeMetricStatusStale returns whether the status is missing, or not updated within the
+// tolerance before now.
+func IsNodeMetricStatusStale(status *nodev1alpha1.NodeMetricStatus, now time.Time, tolerance time.Duration) bool {
+	if status == nil || status.UpdateTime.IsZero() {
+		return true
+	}
+	return now.Sub(status.UpdateTime.Time) > tolerance
+}
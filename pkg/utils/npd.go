@@ -0,0 +1,341 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"fmt"
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
+
+	nodev1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/node/v1alpha1"
+	"github.com/kubewharf/katalyst-api/pkg/consts"
+)
+
+// index names of npd in informer caches
+const (
+	// NPDScopeIndex indexes npd by the scopes of node and pod metrics in status
+	NPDScopeIndex = "npd-scope"
+	// NPDPodIndex indexes npd by namespace/name of the pods in status, and shards of pod metrics
+	// are indexed as well; use NPDPrimariesByIndex to resolve them to the primary npd
+	NPDPodIndex = "npd-pod"
+	// NPDMetricIndex indexes npd by scope/metricName of node and pod metrics in status, and shards
+	// of pod metrics are indexed as well; use NPDPrimariesByIndex to resolve them to the primary npd
+	NPDMetricIndex = "npd-metric"
+	// NPDPrimaryIndex indexes npd by the name of the primary npd, which is the name of itself
+	// if it's not a shard of pod metrics
+	NPDPrimaryIndex = "npd-primary"
+)
+
+// NPDMetricKey identifies a time series of a metric in npd status.
+type NPDMetricKey struct {
+	MetricName string
+	// Labels must be equal to the labels of the time series, and nil is equal to empty.
+	Labels map[string]string
+	// Aggregator and Window must be equal to those of the time series, and nil only
+	// matches the instantaneous value.
+	Aggregator *nodev1alpha1.Aggregator
+	Window     *metav1.Duration
+}
+
+func (k *NPDMetricKey) matches(value *nodev1alpha1.MetricValue) bool {
+	if value.MetricName != k.MetricName || len(value.MetricLabels) != len(k.Labels) {
+		return false
+	}
+	for name, v := range k.Labels {
+		if labelValue, ok := value.MetricLabels[name]; !ok || labelValue != v {
+			return false
+		}
+	}
+
+	if (k.Aggregator == nil) != (value.Aggregator == nil) ||
+		(k.Aggregator != nil && *k.Aggregator != *value.Aggregator) {
+		return false
+	}
+	if (k.Window == nil) != (value.Window == nil) ||
+		(k.Window != nil && k.Window.Duration != value.Window.Duration) {
+		return false
+	}
+	return true
+}
+
+// NPDMetricQuerier looks up metrics in npd status by scope, pod and metric name, and
+// entries with the same scope or pod are merged. Returned values point to the status,
+// and callers should not modify them.
+type NPDMetricQuerier struct {
+	nodeMetrics map[string]map[string][]*nodev1alpha1.MetricValue
+	podMetrics  map[string]map[types.NamespacedName]map[string][]*nodev1alpha1.MetricValue
+}
+
+// NewNPDMetricQuerier returns a querier over the status, which is not copied. It returns error
+// if the status has shards of pod metrics, since the querier would miss the pod metrics in them;
+// use NewShardedNPDMetricQuerier or NewNPDMetricQuerierFromIndexer for sharded npd instead.
+func NewNPDMetricQuerier(status *nodev1alpha1.NodeProfileDescriptorStatus) (*NPDMetricQuerier, error) {
+	q := &NPDMetricQuerier{
+		nodeMetrics: make(map[string]map[string][]*nodev1alpha1.MetricValue),
+		podMetrics:  make(map[string]map[types.NamespacedName]map[string][]*nodev1alpha1.MetricValue),
+	}
+	if status != nil {
+		if len(status.PodMetricShards) > 0 {
+			return nil, fmt.Errorf("npd status has pod metric shards %v, which must be merged first", status.PodMetricShards)
+		}
+		q.add(status)
+	}
+	return q, nil
+}
+
+func (q *NPDMetricQuerier) add(status *nodev1alpha1.NodeProfileDescriptorStatus) {
+	for i := range status.NodeMetrics {
+		scoped := &status.NodeMetrics[i]
+		if q.nodeMetrics[scoped.Scope] == nil {
+			q.nodeMetrics[scoped.Scope] = make(map[string][]*nodev1alpha1.MetricValue)
+		}
+		indexMetricValues(q.nodeMetrics[scoped.Scope], scoped.Metrics)
+	}
+
+	for i := range status.PodMetrics {
+		scoped := &status.PodMetrics[i]
+		if q.podMetrics[scoped.Scope] == nil {
+			q.podMetrics[scoped.Scope] = make(map[types.NamespacedName]map[string][]*nodev1alpha1.MetricValue)
+		}
+		for j := range scoped.PodMetrics {
+			pod := &scoped.PodMetrics[j]
+			podKey := types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name}
+			if q.podMetrics[scoped.Scope][podKey] == nil {
+				q.podMetrics[scoped.Scope][podKey] = make(map[string][]*nodev1alpha1.MetricValue)
+			}
+			indexMetricValues(q.podMetrics[scoped.Scope][podKey], pod.Metrics)
+		}
+	}
+}
+
+// GetNodeMetric returns the latest value of the node metric by timestamp.
+func (q *NPDMetricQuerier) GetNodeMetric(scope string, key NPDMetricKey) (*nodev1alpha1.MetricValue, bool) {
+	return latestMetricValue(q.nodeMetrics[scope][key.MetricName], &key)
+}
+
+// GetPodMetric returns the latest value of the pod metric by timestamp.
+func (q *NPDMetricQuerier) GetPodMetric(scope, namespace, name string, key NPDMetricKey) (*nodev1alpha1.MetricValue, bool) {
+	podKey := types.NamespacedName{Namespace: namespace, Name: name}
+	return latestMetricValue(q.podMetrics[scope][podKey][key.MetricName], &key)
+}
+
+// ListNodeMetrics returns all the values of the node metric in the scope, e.g. values with
+// different labels or aggregators.
+func (q *NPDMetricQuerier) ListNodeMetrics(scope, metricName string) []*nodev1alpha1.MetricValue {
+	return q.nodeMetrics[scope][metricName]
+}
+
+// ListPodMetrics returns all the values of the pod metric in the scope.
+func (q *NPDMetricQuerier) ListPodMetrics(scope, namespace, name, metricName string) []*nodev1alpha1.MetricValue {
+	return q.podMetrics[scope][types.NamespacedName{Namespace: namespace, Name: name}][metricName]
+}
+
+// HasPod returns whether any metric of the pod exists in the scope.
+func (q *NPDMetricQuerier) HasPod(scope, namespace, name string) bool {
+	_, ok := q.podMetrics[scope][types.NamespacedName{Namespace: namespace, Name: name}]
+	return ok
+}
+
+// Pods returns the sorted pods with metrics in the scope.
+func (q *NPDMetricQuerier) Pods(scope string) []types.NamespacedName {
+	pods := make([]types.NamespacedName, 0, len(q.podMetrics[scope]))
+	for pod := range q.podMetrics[scope] {
+		pods = append(pods, pod)
+	}
+	sort.Slice(pods, func(i, j int) bool {
+		return pods[i].String() < pods[j].String()
+	})
+	return pods
+}
+
+func indexMetricValues(index map[string][]*nodev1alpha1.MetricValue, values []nodev1alpha1.MetricValue) {
+	for i := range values {
+		index[values[i].MetricName] = append(index[values[i].MetricName], &values[i])
+	}
+}
+
+// latestMetricValue returns the matched value with the latest timestamp, and the first
+// one wins for ties.
+func latestMetricValue(values []*nodev1alpha1.MetricValue, key *NPDMetricKey) (*nodev1alpha1.MetricValue, bool) {
+	var latest *nodev1alpha1.MetricValue
+	for _, value := range values {
+		if key.matches(value) && (latest == nil || latest.Timestamp.Before(&value.Timestamp)) {
+			latest = value
+		}
+	}
+	return latest, latest != nil
+}
+
+// NPDIndexers returns all the indexers of npd, which can be added to npd informers.
+func NPDIndexers() cache.Indexers {
+	return cache.Indexers{
+		NPDScopeIndex:   NPDScopeIndexFunc,
+		NPDPodIndex:     NPDPodIndexFunc,
+		NPDMetricIndex:  NPDMetricIndexFunc,
+		NPDPrimaryIndex: NPDPrimaryIndexFunc,
+	}
+}
+
+// NPDScopeIndexFunc is the index func of NPDScopeIndex.
+func NPDScopeIndexFunc(obj interface{}) ([]string, error) {
+	npd, ok := obj.(*nodev1alpha1.NodeProfileDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("unexpected object type %T", obj)
+	}
+
+	keys := newIndexKeys()
+	for _, scoped := range npd.Status.NodeMetrics {
+		keys.add(scoped.Scope)
+	}
+	for _, scoped := range npd.Status.PodMetrics {
+		keys.add(scoped.Scope)
+	}
+	return keys.list, nil
+}
+
+// NPDPodIndexFunc is the index func of NPDPodIndex, and the keys are namespace/name of pods.
+func NPDPodIndexFunc(obj interface{}) ([]string, error) {
+	npd, ok := obj.(*nodev1alpha1.NodeProfileDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("unexpected object type %T", obj)
+	}
+
+	keys := newIndexKeys()
+	for _, scoped := range npd.Status.PodMetrics {
+		for _, pod := range scoped.PodMetrics {
+			keys.add(types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name}.String())
+		}
+	}
+	return keys.list, nil
+}
+
+// NPDMetricIndexFunc is the index func of NPDMetricIndex, and the keys are built by NPDMetricIndexKey.
+func NPDMetricIndexFunc(obj interface{}) ([]string, error) {
+	npd, ok := obj.(*nodev1alpha1.NodeProfileDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("unexpected object type %T", obj)
+	}
+
+	keys := newIndexKeys()
+	for _, scoped := range npd.Status.NodeMetrics {
+		for _, value := range scoped.Metrics {
+			keys.add(NPDMetricIndexKey(scoped.Scope, value.MetricName))
+		}
+	}
+	for _, scoped := range npd.Status.PodMetrics {
+		for _, pod := range scoped.PodMetrics {
+			for _, value := range pod.Metrics {
+				keys.add(NPDMetricIndexKey(scoped.Scope, value.MetricName))
+			}
+		}
+	}
+	return keys.list, nil
+}
+
+// NPDPrimaryIndexFunc is the index func of NPDPrimaryIndex.
+func NPDPrimaryIndexFunc(obj interface{}) ([]string, error) {
+	npd, ok := obj.(*nodev1alpha1.NodeProfileDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("unexpected object type %T", obj)
+	}
+
+	return []string{NPDPrimaryName(npd)}, nil
+}
+
+// NPDPrimaryName returns the name of the primary npd of the shard, or the name of the npd
+// itself if it's not a shard.
+func NPDPrimaryName(npd *nodev1alpha1.NodeProfileDescriptor) string {
+	if primary, ok := npd.Labels[consts.NPDLabelPrimaryKey]; ok {
+		return primary
+	}
+	return npd.Name
+}
+
+// NPDPrimariesByIndex returns the sorted names of primary npd whose objects match the index key,
+// and shards are resolved to their primary npd, e.g. NPDPodIndex returns the primary npd instead
+// of the shard for pods whose metrics are in shards.
+func NPDPrimariesByIndex(indexer cache.Indexer, indexName, key string) ([]string, error) {
+	objs, err := indexer.ByIndex(indexName, key)
+	if err != nil {
+		return nil, err
+	}
+
+	names := sets.NewString()
+	for _, obj := range objs {
+		npd, ok := obj.(*nodev1alpha1.NodeProfileDescriptor)
+		if !ok {
+			return nil, fmt.Errorf("unexpected object type %T", obj)
+		}
+		names.Insert(NPDPrimaryName(npd))
+	}
+	return names.List(), nil
+}
+
+// NewNPDMetricQuerierFromIndexer returns a querier over the primary npd and its shards in the
+// indexer, which must have NPDPrimaryIndex.
+func NewNPDMetricQuerierFromIndexer(indexer cache.Indexer, primaryName string) (*NPDMetricQuerier, error) {
+	objs, err := indexer.ByIndex(NPDPrimaryIndex, primaryName)
+	if err != nil {
+		return nil, err
+	}
+
+	var primary *nodev1alpha1.NodeProfileDescriptor
+	var shards []*nodev1alpha1.NodeProfileDescriptor
+	for _, obj := range objs {
+		npd, ok := obj.(*nodev1alpha1.NodeProfileDescriptor)
+		if !ok {
+			return nil, fmt.Errorf("unexpected object type %T", obj)
+		}
+
+		if _, isShard := npd.Labels[consts.NPDLabelPrimaryKey]; isShard {
+			shards = append(shards, npd)
+		} else if npd.Name == primaryName {
+			primary = npd
+		}
+	}
+
+	if primary == nil {
+		return nil, fmt.Errorf("npd %s is not found", primaryName)
+	}
+	return NewShardedNPDMetricQuerier(primary, shards)
+}
+
+// NPDMetricIndexKey returns the key of NPDMetricIndex for the metric in the scope.
+func NPDMetricIndexKey(scope, metricName string) string {
+	return scope + "/" + metricName
+}
+
+// indexKeys collects deduplicated index keys in order
+type indexKeys struct {
+	seen map[string]bool
+	list []string
+}
+
+func newIndexKeys() *indexKeys {
+	return &indexKeys{seen: make(map[string]bool)}
+}
+
+func (k *indexKeys) add(key string) {
+	if !k.seen[key] {
+		k.seen[key] = true
+		k.list = append(k.list, key)
+	}
+}
@@ -0,0 +1,201 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	nodev1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/node/v1alpha1"
+)
+
+// MetricPoint is a point in the series of a metric
+type MetricPoint struct {
+	Timestamp metav1.Time
+	Value     resource.Quantity
+}
+
+// MetricRetention bounds the series of a metric, and zero means unlimited.
+type MetricRetention struct {
+	// MaxPoints is the max number of points including the latest value.
+	MaxPoints int
+	// MaxAge is the max age of points relative to the latest value.
+	MaxAge time.Duration
+}
+
+// MetricSeriesPoints decodes all the points of the metric from the oldest to the latest, and
+// the latest is the value itself; it returns nil if the metric has no timestamp.
+func MetricSeriesPoints(value *nodev1alpha1.MetricValue) ([]MetricPoint, error) {
+	if value.Timestamp.IsZero() {
+		return nil, nil
+	}
+
+	var timestampDeltas, valueDeltas []int64
+	if value.Series != nil {
+		timestampDeltas, valueDeltas = value.Series.TimestampDeltas, value.Series.ValueDeltas
+		if len(timestampDeltas) != len(valueDeltas) {
+			return nil, fmt.Errorf("metric %s has %d timestamp deltas but %d value deltas",
+				value.MetricName, len(timestampDeltas), len(valueDeltas))
+		}
+	}
+
+	points := make([]MetricPoint, len(timestampDeltas)+1)
+	timestamp, milli := value.Timestamp.Time, value.Value.MilliValue()
+	points[len(points)-1] = MetricPoint{Timestamp: value.Timestamp, Value: value.Value.DeepCopy()}
+	for i := range timestampDeltas {
+		if timestampDeltas[i] < 0 {
+			return nil, fmt.Errorf("metric %s has negative timestamp delta %d", value.MetricName, timestampDeltas[i])
+		}
+
+		timestamp = timestamp.Add(-time.Duration(timestampDeltas[i]) * time.Millisecond)
+		var ok bool
+		if milli, ok = subMilli(milli, valueDeltas[i]); !ok {
+			return nil, fmt.Errorf("metric %s has value delta %d out of range", value.MetricName, valueDeltas[i])
+		}
+		points[len(points)-2-i] = MetricPoint{
+			Timestamp: metav1.NewTime(timestamp),
+			Value:     *resource.NewMilliQuantity(milli, value.Value.Format),
+		}
+	}
+	return points, nil
+}
+
+// SetMetricSeriesPoints sets the latest point as the value of the metric, and encodes the
+// others as its series; points must be sorted from the oldest to the latest, and values of
+// series must be represented exactly in milli-units, which are the units of value deltas.
+// Timestamps are truncated to seconds as MetricValue.Timestamp is serialized in seconds, and
+// only the latest one of points in the same second is kept.
+func SetMetricSeriesPoints(value *nodev1alpha1.MetricValue, points []MetricPoint) error {
+	for i := 1; i < len(points); i++ {
+		if points[i].Timestamp.Before(&points[i-1].Timestamp) {
+			return fmt.Errorf("points of metric %s are not sorted by timestamp", value.MetricName)
+		}
+	}
+	points = truncateMetricPoints(points)
+
+	var series *nodev1alpha1.MetricSeries
+	if len(points) > 1 {
+		millis := make([]int64, len(points))
+		for i := range points {
+			milli := points[i].Value.MilliValue()
+			if resource.NewMilliQuantity(milli, points[i].Value.Format).Cmp(points[i].Value) != 0 {
+				return fmt.Errorf("value %s of metric %s can't be represented exactly in milli-units",
+					points[i].Value.String(), value.MetricName)
+			}
+			millis[i] = milli
+		}
+
+		series = &nodev1alpha1.MetricSeries{
+			TimestampDeltas: make([]int64, 0, len(points)-1),
+			ValueDeltas:     make([]int64, 0, len(points)-1),
+		}
+		for i := len(points) - 1; i > 0; i-- {
+			delta, ok := subMilli(millis[i], millis[i-1])
+			if !ok {
+				return fmt.Errorf("difference between values %s and %s of metric %s overflows in milli-units",
+					points[i].Value.String(), points[i-1].Value.String(), value.MetricName)
+			}
+
+			newer, older := points[i], points[i-1]
+			series.TimestampDeltas = append(series.TimestampDeltas, newer.Timestamp.Sub(older.Timestamp.Time).Milliseconds())
+			series.ValueDeltas = append(series.ValueDeltas, delta)
+		}
+	}
+
+	value.Series = series
+	if len(points) == 0 {
+		value.Timestamp, value.Value = metav1.Time{}, resource.Quantity{}
+		return nil
+	}
+
+	latest := points[len(points)-1]
+	value.Timestamp, value.Value = latest.Timestamp, latest.Value.DeepCopy()
+	return nil
+}
+
+// subMilli returns a-b, and returns false if it overflows
+func subMilli(a, b int64) (int64, bool) {
+	if (b > 0 && a < math.MinInt64+b) || (b < 0 && a > math.MaxInt64+b) {
+		return 0, false
+	}
+	return a - b, true
+}
+
+// AppendMetricPoint appends the point as the latest value of the metric, and drops the points
+// beyond retention. A point in the same second as the latest value replaces it, and an older
+// point is rejected.
+func AppendMetricPoint(value *nodev1alpha1.MetricValue, point MetricPoint, retention MetricRetention) error {
+	points, err := MetricSeriesPoints(value)
+	if err != nil {
+		return err
+	}
+
+	point.Timestamp = truncateMetricTimestamp(point.Timestamp)
+
+	if len(points) > 0 {
+		latest := points[len(points)-1]
+		switch {
+		case point.Timestamp.Before(&latest.Timestamp):
+			return fmt.Errorf("point at %v is older than the latest value of metric %s at %v",
+				point.Timestamp, value.MetricName, latest.Timestamp)
+		case point.Timestamp.Equal(&latest.Timestamp):
+			points = points[:len(points)-1]
+		}
+	}
+	points = append(points, point)
+
+	return SetMetricSeriesPoints(value, retainMetricPoints(points, retention))
+}
+
+// truncateMetricPoints returns the points with timestamps truncated to seconds, and only the
+// latest one of points in the same second is kept; the given points are not changed.
+func truncateMetricPoints(points []MetricPoint) []MetricPoint {
+	result := make([]MetricPoint, 0, len(points))
+	for _, point := range points {
+		point.Timestamp = truncateMetricTimestamp(point.Timestamp)
+		if len(result) > 0 && result[len(result)-1].Timestamp.Equal(&point.Timestamp) {
+			result = result[:len(result)-1]
+		}
+		result = append(result, point)
+	}
+	return result
+}
+
+// truncateMetricTimestamp truncates the timestamp to seconds, which is the precision of
+// metav1.Time in serialization.
+func truncateMetricTimestamp(timestamp metav1.Time) metav1.Time {
+	return metav1.NewTime(timestamp.Time.Truncate(time.Second))
+}
+
+// retainMetricPoints returns the tail of the sorted points within retention
+func retainMetricPoints(points []MetricPoint, retention MetricRetention) []MetricPoint {
+	if retention.MaxPoints > 0 && len(points) > retention.MaxPoints {
+		points = points[len(points)-retention.MaxPoints:]
+	}
+
+	if retention.MaxAge > 0 && len(points) > 0 {
+		oldest := points[len(points)-1].Timestamp.Add(-retention.MaxAge)
+		for len(points) > 1 && points[0].Timestamp.Time.Before(oldest) {
+			points = points[1:]
+		}
+	}
+	return points
+}
@@ -0,0 +1,208 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	nodev1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/node/v1alpha1"
+	"github.com/kubewharf/katalyst-api/pkg/consts"
+)
+
+// podMetricsFieldSize is the size of `,"podMetrics":[]` added to an object when the
+// first pod metric is added
+const podMetricsFieldSize = len(`,"podMetrics":[]`)
+
+// EstimateNPDSize returns the size of the npd serialized in json, which is how custom
+// resources are stored in etcd.
+func EstimateNPDSize(npd *nodev1alpha1.NodeProfileDescriptor) (int, error) {
+	data, err := json.Marshal(npd)
+	if err != nil {
+		return 0, err
+	}
+	return len(data), nil
+}
+
+// NPDShardName returns the name of the shard of the primary npd, and index starts from 0.
+func NPDShardName(primary string, index int) string {
+	return fmt.Sprintf("%s-shard-%d", primary, index)
+}
+
+// ShardNPDPodMetrics splits the npd into objects whose sizes are within maxSize. If the npd
+// fits, it's returned as the primary without shards; otherwise the primary keeps the node
+// metrics and lists the shards in status, and pod metrics are packed into the shards with
+// pods as the unit. The npd is not modified.
+func ShardNPDPodMetrics(npd *nodev1alpha1.NodeProfileDescriptor, maxSize int) (*nodev1alpha1.NodeProfileDescriptor,
+	[]*nodev1alpha1.NodeProfileDescriptor, error,
+) {
+	primary := npd.DeepCopy()
+	primary.Status.PodMetricShards = nil
+	size, err := EstimateNPDSize(primary)
+	if err != nil {
+		return nil, nil, err
+	} else if size <= maxSize {
+		return primary, nil, nil
+	}
+
+	primary.Status.PodMetrics = nil
+	newShard := func() (*shardBuilder, error) {
+		shard := &nodev1alpha1.NodeProfileDescriptor{
+			TypeMeta: npd.TypeMeta,
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   NPDShardName(npd.Name, len(primary.Status.PodMetricShards)),
+				Labels: map[string]string{consts.NPDLabelPrimaryKey: npd.Name},
+			},
+		}
+		primary.Status.PodMetricShards = append(primary.Status.PodMetricShards, shard.Name)
+
+		size, err := EstimateNPDSize(shard)
+		if err != nil {
+			return nil, err
+		}
+		return &shardBuilder{npd: shard, size: size + podMetricsFieldSize, scopes: make(map[string]int)}, nil
+	}
+
+	var shards []*nodev1alpha1.NodeProfileDescriptor
+	var current *shardBuilder
+	for _, scoped := range npd.Status.PodMetrics {
+		scopeData, err := json.Marshal(nodev1alpha1.ScopedPodMetrics{Scope: scoped.Scope})
+		if err != nil {
+			return nil, nil, err
+		}
+		scopeSize := len(scopeData) + podMetricsFieldSize + 1
+
+		for i := range scoped.PodMetrics {
+			podData, err := json.Marshal(&scoped.PodMetrics[i])
+			if err != nil {
+				return nil, nil, err
+			}
+			podSize := len(podData) + 1
+
+			if current == nil || !current.fits(scoped.Scope, scopeSize, podSize, maxSize) {
+				if current, err = newShard(); err != nil {
+					return nil, nil, err
+				}
+				shards = append(shards, current.npd)
+
+				if !current.fits(scoped.Scope, scopeSize, podSize, maxSize) {
+					return nil, nil, fmt.Errorf("metrics of pod %s/%s in scope %s are too large for npd size limit %d",
+						scoped.PodMetrics[i].Namespace, scoped.PodMetrics[i].Name, scoped.Scope, maxSize)
+				}
+			}
+			current.add(scoped.Scope, scopeSize, podSize, scoped.PodMetrics[i].DeepCopy())
+		}
+	}
+
+	for _, obj := range append([]*nodev1alpha1.NodeProfileDescriptor{primary}, shards...) {
+		if size, err := EstimateNPDSize(obj); err != nil {
+			return nil, nil, err
+		} else if size > maxSize {
+			return nil, nil, fmt.Errorf("size %d of npd %s exceeds limit %d", size, obj.Name, maxSize)
+		}
+	}
+	return primary, shards, nil
+}
+
+// shardBuilder packs pod metrics into a shard with estimated size, which is not less than
+// the actual size.
+type shardBuilder struct {
+	npd  *nodev1alpha1.NodeProfileDescriptor
+	size int
+	// scopes are indexes of scopes in pod metrics of the shard
+	scopes map[string]int
+}
+
+func (b *shardBuilder) fits(scope string, scopeSize, podSize, maxSize int) bool {
+	size := b.size + podSize
+	if _, ok := b.scopes[scope]; !ok {
+		size += scopeSize
+	}
+	return size <= maxSize
+}
+
+func (b *shardBuilder) add(scope string, scopeSize, podSize int, pod *nodev1alpha1.PodMetric) {
+	index, ok := b.scopes[scope]
+	if !ok {
+		index = len(b.npd.Status.PodMetrics)
+		b.scopes[scope] = index
+		b.npd.Status.PodMetrics = append(b.npd.Status.PodMetrics, nodev1alpha1.ScopedPodMetrics{Scope: scope})
+		b.size += scopeSize
+	}
+
+	b.npd.Status.PodMetrics[index].PodMetrics = append(b.npd.Status.PodMetrics[index].PodMetrics, *pod)
+	b.size += podSize
+}
+
+// MergeNPDShards returns a copy of the primary npd with pod metrics of all the shards listed
+// in its status, and returns error if any listed shard is not found; shards not listed or
+// not labeled with the primary are ignored, since they may be stale.
+func MergeNPDShards(primary *nodev1alpha1.NodeProfileDescriptor,
+	shards []*nodev1alpha1.NodeProfileDescriptor,
+) (*nodev1alpha1.NodeProfileDescriptor, error) {
+	merged := primary.DeepCopy()
+	merged.Status.PodMetricShards = nil
+	if len(primary.Status.PodMetricShards) == 0 {
+		return merged, nil
+	}
+
+	shardByName := make(map[string]*nodev1alpha1.NodeProfileDescriptor, len(shards))
+	for _, shard := range shards {
+		if shard.Labels[consts.NPDLabelPrimaryKey] == primary.Name {
+			shardByName[shard.Name] = shard
+		}
+	}
+
+	scopes := make(map[string]int, len(merged.Status.PodMetrics))
+	for i, scoped := range merged.Status.PodMetrics {
+		scopes[scoped.Scope] = i
+	}
+	for _, name := range primary.Status.PodMetricShards {
+		shard, ok := shardByName[name]
+		if !ok {
+			return nil, fmt.Errorf("shard %s of npd %s is not found", name, primary.Name)
+		}
+
+		for _, scoped := range shard.Status.PodMetrics {
+			index, ok := scopes[scoped.Scope]
+			if !ok {
+				index = len(merged.Status.PodMetrics)
+				scopes[scoped.Scope] = index
+				merged.Status.PodMetrics = append(merged.Status.PodMetrics, nodev1alpha1.ScopedPodMetrics{Scope: scoped.Scope})
+			}
+
+			for i := range scoped.PodMetrics {
+				merged.Status.PodMetrics[index].PodMetrics = append(merged.Status.PodMetrics[index].PodMetrics,
+					*scoped.PodMetrics[i].DeepCopy())
+			}
+		}
+	}
+	return merged, nil
+}
+
+// NewShardedNPDMetricQuerier returns a querier over the status of the primary npd and its shards.
+func NewShardedNPDMetricQuerier(primary *nodev1alpha1.NodeProfileDescriptor,
+	shards []*nodev1alpha1.NodeProfileDescriptor,
+) (*NPDMetricQuerier, error) {
+	merged, err := MergeNPDShards(primary, shards)
+	if err != nil {
+		return nil, err
+	}
+	return NewNPDMetricQuerier(&merged.Status)
+}
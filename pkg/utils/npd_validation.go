@@ -0,0 +1,102 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	nodev1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/node/v1alpha1"
+)
+
+// supportedAggregators are the aggregators that can be declared in npd spec
+var supportedAggregators = sets.NewString(
+	string(nodev1alpha1.AggregatorAvg),
+	string(nodev1alpha1.AggregatorMax),
+	string(nodev1alpha1.AggregatorMin),
+	string(nodev1alpha1.AggregatorCount),
+	string(nodev1alpha1.AggregatorP99),
+	string(nodev1alpha1.AggregatorP95),
+	string(nodev1alpha1.AggregatorP90),
+)
+
+// ValidateNodeProfileDescriptorSpec validates the metrics declared in npd spec. Scopes must be unique
+// within nodeMetrics and podMetrics respectively, and the same scope in both of them is allowed
+// since they are reported into separate lists of npd status.
+func ValidateNodeProfileDescriptorSpec(spec *nodev1alpha1.NodeProfileDescriptorSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, validateScopedMetricDeclarations(spec.NodeMetrics, fldPath.Child("nodeMetrics"))...)
+	allErrs = append(allErrs, validateScopedMetricDeclarations(spec.PodMetrics, fldPath.Child("podMetrics"))...)
+	return allErrs
+}
+
+func validateScopedMetricDeclarations(scopedMetrics []nodev1alpha1.ScopedMetricDeclarations, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	scopes := sets.NewString()
+	for i, scoped := range scopedMetrics {
+		scopePath := fldPath.Index(i).Child("scope")
+		if scoped.Scope == "" {
+			allErrs = append(allErrs, field.Required(scopePath, ""))
+		} else if scopes.Has(scoped.Scope) {
+			allErrs = append(allErrs, field.Duplicate(scopePath, scoped.Scope))
+		}
+		scopes.Insert(scoped.Scope)
+
+		metricNames := sets.NewString()
+		for j, metric := range scoped.Metrics {
+			metricPath := fldPath.Index(i).Child("metrics").Index(j)
+			allErrs = append(allErrs, validateMetricDeclaration(&metric, metricPath)...)
+
+			if metric.MetricName != "" && metricNames.Has(metric.MetricName) {
+				allErrs = append(allErrs, field.Duplicate(metricPath.Child("metricName"), metric.MetricName))
+			}
+			metricNames.Insert(metric.MetricName)
+		}
+	}
+	return allErrs
+}
+
+func validateMetricDeclaration(metric *nodev1alpha1.MetricDeclaration, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if metric.MetricName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("metricName"), ""))
+	}
+
+	aggregators := sets.NewString()
+	for i, aggregator := range metric.Aggregators {
+		aggregatorPath := fldPath.Child("aggregators").Index(i)
+		if !supportedAggregators.Has(string(aggregator)) {
+			allErrs = append(allErrs, field.NotSupported(aggregatorPath, aggregator, supportedAggregators.List()))
+		} else if aggregators.Has(string(aggregator)) {
+			allErrs = append(allErrs, field.Duplicate(aggregatorPath, aggregator))
+		}
+		aggregators.Insert(string(aggregator))
+	}
+
+	windowPath := fldPath.Child("window")
+	if metric.Window != nil && metric.Window.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(windowPath, metric.Window.Duration.String(), "must be positive"))
+	} else if metric.Window == nil && len(metric.Aggregators) > 0 {
+		allErrs = append(allErrs, field.Required(windowPath, "required when aggregators are specified"))
+	}
+
+	allErrs = append(allErrs, metav1validation.ValidateLabelSelector(metric.LabelSelector, fldPath.Child("labelSelector"))...)
+	return allErrs
+}
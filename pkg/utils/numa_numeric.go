@@ -0,0 +1,280 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	nodev1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/node/v1alpha1"
+	"github.com/kubewharf/katalyst-api/pkg/consts"
+)
+
+// NUMARequirement is the numa requirement of a pod for numeric topology policies. Unlike the other
+// policies which bind a pod (or container) to the narrowest set of numa nodes that satisfies its
+// requests, numeric policies bind it to exactly the number of numa nodes it asks for, within the
+// set of numa nodes it allows.
+type NUMARequirement struct {
+	// NUMANumber is the number of numa nodes required, and 0 means not specified.
+	NUMANumber int
+	// NUMAIDs are the sorted ids of numa nodes allowed, and the numa nodes bound must be within them
+	// regardless of NUMANumber; empty means all the numa nodes are allowed.
+	NUMAIDs []int
+
+	// AlignBySocket requires the numa nodes to be within the fewest sockets possible.
+	AlignBySocket bool
+	// DistributeEvenlyAcrossNUMA requires each numa node to satisfy an equal share of the requests.
+	DistributeEvenlyAcrossNUMA bool
+}
+
+// IsEmpty returns whether nothing is required
+func (r *NUMARequirement) IsEmpty() bool {
+	return r.NUMANumber == 0 && len(r.NUMAIDs) == 0 && !r.AlignBySocket && !r.DistributeEvenlyAcrossNUMA
+}
+
+func (r *NUMARequirement) String() string {
+	var parts []string
+	if r.NUMANumber > 0 {
+		parts = append(parts, fmt.Sprintf("numa number %d", r.NUMANumber))
+	}
+	if len(r.NUMAIDs) > 0 {
+		parts = append(parts, fmt.Sprintf("numa ids %s", formatIDList(r.NUMAIDs)))
+	}
+	if r.AlignBySocket {
+		parts = append(parts, consts.PodAnnotationCPUEnhancementAlignBySocket)
+	}
+	if r.DistributeEvenlyAcrossNUMA {
+		parts = append(parts, consts.PodAnnotationCPUEnhancementDistributeEvenlyAcrossNuma)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// ParseNUMARequirement parses the numa requirement from pod annotations.
+func ParseNUMARequirement(annotations map[string]string) (*NUMARequirement, error) {
+	if errs := ValidateNUMARequirement(annotations, field.NewPath("metadata", "annotations")); len(errs) > 0 {
+		return nil, errs.ToAggregate()
+	}
+
+	requirement := &NUMARequirement{}
+	if value, ok := annotations[consts.PodAnnotationCPUEnhancementNumaNumber]; ok {
+		requirement.NUMANumber, _ = strconv.Atoi(strings.TrimSpace(value))
+	}
+	if value, ok := annotations[consts.PodAnnotationCPUEnhancementNumaIDs]; ok {
+		ids, err := parseIDList(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s %q: %v", consts.PodAnnotationCPUEnhancementNumaIDs, value, err)
+		}
+		requirement.NUMAIDs = ids
+	}
+
+	enhancement, _ := parseCPUEnhancement(annotations)
+	requirement.AlignBySocket = enhancement[consts.PodAnnotationCPUEnhancementAlignBySocket] ==
+		consts.PodAnnotationCPUEnhancementAlignBySocketEnable
+	requirement.DistributeEvenlyAcrossNUMA = enhancement[consts.PodAnnotationCPUEnhancementDistributeEvenlyAcrossNuma] ==
+		consts.PodAnnotationCPUEnhancementDistributeEvenlyAcrossNumaEnable
+	return requirement, nil
+}
+
+// ValidateNUMARequirement validates the annotations about numa requirement, and fldPath is the
+// path of the annotations.
+func ValidateNUMARequirement(annotations map[string]string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if value, ok := annotations[consts.PodAnnotationCPUEnhancementNumaNumber]; ok {
+		numberPath := fldPath.Key(consts.PodAnnotationCPUEnhancementNumaNumber)
+		if number, err := strconv.Atoi(strings.TrimSpace(value)); err != nil || number <= 0 {
+			allErrs = append(allErrs, field.Invalid(numberPath, value, "must be a positive integer"))
+		}
+	}
+
+	if value, ok := annotations[consts.PodAnnotationCPUEnhancementNumaIDs]; ok {
+		idsPath := fldPath.Key(consts.PodAnnotationCPUEnhancementNumaIDs)
+		ids, err := parseIDList(value)
+		if err == nil {
+			err = validateIDList(ids)
+		}
+
+		switch {
+		case err != nil:
+			allErrs = append(allErrs, field.Invalid(idsPath, value, err.Error()))
+		case len(ids) == 0:
+			allErrs = append(allErrs, field.Invalid(idsPath, value, "must not be empty"))
+		}
+	}
+
+	enhancementPath := fldPath.Key(consts.PodAnnotationCPUEnhancementKey)
+	enhancement, err := parseCPUEnhancement(annotations)
+	if err != nil {
+		return append(allErrs, field.Invalid(enhancementPath, annotations[consts.PodAnnotationCPUEnhancementKey], err.Error()))
+	}
+	// consumers only enable them with exactly the enable values, so other forms of booleans
+	// (e.g. "True" or "1") are rejected instead of being silently treated as disabled
+	for _, option := range []struct{ key, enable string }{
+		{consts.PodAnnotationCPUEnhancementAlignBySocket, consts.PodAnnotationCPUEnhancementAlignBySocketEnable},
+		{consts.PodAnnotationCPUEnhancementDistributeEvenlyAcrossNuma, consts.PodAnnotationCPUEnhancementDistributeEvenlyAcrossNumaEnable},
+	} {
+		if value, ok := enhancement[option.key]; ok && value != option.enable && value != "false" {
+			allErrs = append(allErrs, field.Invalid(enhancementPath, annotations[consts.PodAnnotationCPUEnhancementKey],
+				fmt.Sprintf("%s must be %q or %q", option.key, option.enable, "false")))
+		}
+	}
+	return allErrs
+}
+
+// NUMACandidates returns the candidate combinations of numa ids in the tree for the requirement in
+// lexicographical order, whose free resources satisfy the requests; if number is not required, the
+// combinations have the narrowest width whose allocatable within the allowed ids satisfies the requests.
+func NUMACandidates(zones []*nodev1alpha1.TopologyZone, requirement *NUMARequirement, requests v1.ResourceList) [][]int {
+	names := make([]v1.ResourceName, 0, len(requests))
+	for name := range requests {
+		names = append(names, name)
+	}
+
+	numaNodes := numaNodesOf(zones, names)
+	return numericCandidates(numaNodes, numaNodes, requirement, requests)
+}
+
+// numericCandidates returns combinations of the available numa nodes for the requirement, and
+// widths and sockets are decided by all the numa nodes allowed by the requirement.
+func numericCandidates(all, available []*numaNode, requirement *NUMARequirement, requests v1.ResourceList) [][]int {
+	allocatable := func(numa *numaNode) v1.ResourceList { return numa.allocatable }
+
+	if len(requirement.NUMAIDs) > 0 {
+		allowed := make(map[int]bool, len(requirement.NUMAIDs))
+		for _, id := range requirement.NUMAIDs {
+			allowed[id] = true
+		}
+		all, available = filterNUMANodes(all, allowed), filterNUMANodes(available, allowed)
+	}
+
+	width := requirement.NUMANumber
+	if width == 0 {
+		if width = len(narrowestNUMANodes(all, requests, allocatable, len(all))); width == 0 {
+			return nil
+		}
+	}
+
+	maxSockets := 0
+	if requirement.AlignBySocket {
+		if maxSockets = fewestSockets(all, requests, width); maxSockets == 0 {
+			return nil
+		}
+	}
+
+	var candidates [][]int
+	forEachCombination(len(available), width, func(indexes []int) bool {
+		sockets := make(map[string]bool)
+		for _, index := range indexes {
+			sockets[available[index].socket] = true
+		}
+		if maxSockets > 0 && len(sockets) > maxSockets {
+			return true
+		}
+
+		if requirement.DistributeEvenlyAcrossNUMA {
+			share := divideResourceList(requests, width)
+			for _, index := range indexes {
+				if !satisfiesResources(available[index].free, share) {
+					return true
+				}
+			}
+		} else {
+			sum := v1.ResourceList{}
+			for _, index := range indexes {
+				addResourceList(sum, available[index].free)
+			}
+			if !satisfiesResources(sum, requests) {
+				return true
+			}
+		}
+
+		candidates = append(candidates, numaIDs(available, indexes))
+		return true
+	})
+	return candidates
+}
+
+// filterNUMANodes returns the numa nodes whose ids are allowed
+func filterNUMANodes(numaNodes []*numaNode, allowed map[int]bool) []*numaNode {
+	result := make([]*numaNode, 0, len(numaNodes))
+	for _, numa := range numaNodes {
+		if allowed[numa.id] {
+			result = append(result, numa)
+		}
+	}
+	return result
+}
+
+// fewestSockets returns the fewest number of sockets whose allocatable satisfies the requests
+// with at least width numa nodes, and returns 0 if no sockets do.
+func fewestSockets(numaNodes []*numaNode, requests v1.ResourceList, width int) int {
+	var sockets []string
+	bySocket := make(map[string][]*numaNode)
+	for _, numa := range numaNodes {
+		if _, ok := bySocket[numa.socket]; !ok {
+			sockets = append(sockets, numa.socket)
+		}
+		bySocket[numa.socket] = append(bySocket[numa.socket], numa)
+	}
+
+	for count := 1; count <= len(sockets); count++ {
+		found := false
+		forEachCombination(len(sockets), count, func(indexes []int) bool {
+			sum, numaCount := v1.ResourceList{}, 0
+			for _, index := range indexes {
+				for _, numa := range bySocket[sockets[index]] {
+					addResourceList(sum, numa.allocatable)
+					numaCount++
+				}
+			}
+
+			found = numaCount >= width && satisfiesResources(sum, requests)
+			return !found
+		})
+		if found {
+			return count
+		}
+	}
+	return 0
+}
+
+// divideResourceList returns the ceiling share of each resource divided by n
+func divideResourceList(list v1.ResourceList, n int) v1.ResourceList {
+	result := make(v1.ResourceList, len(list))
+	for name, quantity := range list {
+		milli := quantity.MilliValue()
+		share := (milli + int64(n) - 1) / int64(n)
+		result[name] = *resource.NewMilliQuantity(share, quantity.Format)
+	}
+	return result
+}
+
+func parseCPUEnhancement(annotations map[string]string) (map[string]string, error) {
+	enhancement := make(map[string]string)
+	if value, ok := annotations[consts.PodAnnotationCPUEnhancementKey]; ok {
+		if err := json.Unmarshal([]byte(value), &enhancement); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal cpu enhancement: %v", err)
+		}
+	}
+	return enhancement, nil
+}
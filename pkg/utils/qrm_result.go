@@ -0,0 +1,391 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/kubewharf/katalyst-api/pkg/consts"
+)
+
+// QRMResultVersion is the format version of qrm allocation result annotations.
+type QRMResultVersion string
+
+const (
+	// QRMResultVersionLegacy is the plain format used before versioning, e.g. "0,1" for numa bind
+	// result and "eth0" for nic selection result, and it only contains the key information.
+	QRMResultVersionLegacy QRMResultVersion = ""
+	// QRMResultVersionV1 is the json format wrapped with version, such as
+	// {"version":"v1","result":{"numaNodes":[0,1]}}.
+	QRMResultVersionV1 QRMResultVersion = "v1"
+)
+
+// qrmResultEnvelope wraps the json format of results with version
+type qrmResultEnvelope struct {
+	Version QRMResultVersion `json:"version"`
+	Result  json.RawMessage  `json:"result"`
+}
+
+// NUMABindResult is the value of annotation consts.PodAnnotationNUMABindResultKey.
+type NUMABindResult struct {
+	// NUMANodes are ids of numa nodes that the pod is bound to, sorted in ascending order
+	NUMANodes []int `json:"numaNodes"`
+}
+
+// NICSelectionResult is the value of annotation consts.PodAnnotationNICSelectionResultKey.
+type NICSelectionResult struct {
+	// InterfaceName is the name of selected nic
+	InterfaceName string `json:"interfaceName"`
+	// NetNSName is the name of network namespace that the nic belongs to, empty means the host one
+	NetNSName string `json:"netNSName,omitempty"`
+	// NUMANodes are ids of numa nodes affinitive to the nic
+	NUMANodes []int `json:"numaNodes,omitempty"`
+}
+
+// GPUSelectionResult is the value of annotation consts.PodAnnotationGPUSelectionResultKey.
+type GPUSelectionResult struct {
+	// DeviceIDs are ids of selected gpu devices, sorted in ascending order
+	DeviceIDs []string `json:"deviceIDs"`
+}
+
+// SriovVF is a sriov virtual function allocated to the pod.
+type SriovVF struct {
+	// PFName is the interface name of the physical function
+	PFName string `json:"pfName"`
+	// VFIndex is the index of the virtual function in the physical function
+	VFIndex int `json:"vfIndex"`
+	// PCIAddress is the pci address of the virtual function
+	PCIAddress string `json:"pciAddress,omitempty"`
+	// NUMANode is the id of numa node affinitive to the virtual function, -1 means unknown
+	NUMANode int `json:"numaNode"`
+}
+
+// SriovVFResult is the value of annotation consts.PodAnnotationSriovVFResultKey, and
+// it has no legacy format.
+type SriovVFResult struct {
+	VFs []SriovVF `json:"vfs"`
+}
+
+// ResourcePoolResult is the value of annotation consts.PodAnnotationResourcePoolKey.
+type ResourcePoolResult struct {
+	Name string `json:"name"`
+}
+
+// ResourcePackageResult is the value of annotation consts.PodAnnotationResourcePackageKey.
+type ResourcePackageResult struct {
+	Name string `json:"name"`
+}
+
+// EncodeNUMABindResult encodes the numa bind result with the given version.
+func EncodeNUMABindResult(result *NUMABindResult, version QRMResultVersion) (string, error) {
+	if err := validateIDList(result.NUMANodes); err != nil {
+		return "", fmt.Errorf("invalid numa nodes: %v", err)
+	}
+	return encodeQRMResult(version, result, func() (string, error) {
+		return formatIDList(result.NUMANodes), nil
+	})
+}
+
+// DecodeNUMABindResult decodes the numa bind result of any version.
+func DecodeNUMABindResult(value string) (*NUMABindResult, error) {
+	result := &NUMABindResult{}
+	err := decodeQRMResult(consts.PodAnnotationNUMABindResultKey, value, result, func(value string) (err error) {
+		result.NUMANodes, err = parseIDList(value)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateIDList(result.NUMANodes); err != nil {
+		return nil, fmt.Errorf("invalid numa nodes in %s: %v", consts.PodAnnotationNUMABindResultKey, err)
+	}
+	return result, nil
+}
+
+// EncodeNICSelectionResult encodes the nic selection result with the given version, and the
+// legacy format only keeps the interface name.
+func EncodeNICSelectionResult(result *NICSelectionResult, version QRMResultVersion) (string, error) {
+	if result.InterfaceName == "" {
+		return "", fmt.Errorf("empty interface name")
+	}
+	if err := validateIDList(result.NUMANodes); err != nil {
+		return "", fmt.Errorf("invalid numa nodes: %v", err)
+	}
+	return encodeQRMResult(version, result, func() (string, error) {
+		return result.InterfaceName, nil
+	})
+}
+
+// DecodeNICSelectionResult decodes the nic selection result of any version.
+func DecodeNICSelectionResult(value string) (*NICSelectionResult, error) {
+	result := &NICSelectionResult{}
+	err := decodeQRMResult(consts.PodAnnotationNICSelectionResultKey, value, result, func(value string) error {
+		result.InterfaceName = value
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result.InterfaceName == "" {
+		return nil, fmt.Errorf("empty interface name in %s", consts.PodAnnotationNICSelectionResultKey)
+	}
+	if err := validateIDList(result.NUMANodes); err != nil {
+		return nil, fmt.Errorf("invalid numa nodes in %s: %v", consts.PodAnnotationNICSelectionResultKey, err)
+	}
+	return result, nil
+}
+
+// EncodeGPUSelectionResult encodes the gpu selection result with the given version, and the
+// device ids are sorted as in decoding without modifying the given result.
+func EncodeGPUSelectionResult(result *GPUSelectionResult, version QRMResultVersion) (string, error) {
+	for _, id := range result.DeviceIDs {
+		if id == "" || strings.Contains(id, ",") {
+			return "", fmt.Errorf("invalid device id %q", id)
+		}
+	}
+
+	sorted := &GPUSelectionResult{DeviceIDs: append([]string(nil), result.DeviceIDs...)}
+	sort.Strings(sorted.DeviceIDs)
+	return encodeQRMResult(version, sorted, func() (string, error) {
+		return strings.Join(sorted.DeviceIDs, ","), nil
+	})
+}
+
+// DecodeGPUSelectionResult decodes the gpu selection result of any version.
+func DecodeGPUSelectionResult(value string) (*GPUSelectionResult, error) {
+	result := &GPUSelectionResult{}
+	err := decodeQRMResult(consts.PodAnnotationGPUSelectionResultKey, value, result, func(value string) error {
+		for _, id := range strings.Split(value, ",") {
+			if id = strings.TrimSpace(id); id != "" {
+				result.DeviceIDs = append(result.DeviceIDs, id)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(result.DeviceIDs)
+	return result, nil
+}
+
+// EncodeSriovVFResult encodes the sriov vf result, and only the json format is supported.
+func EncodeSriovVFResult(result *SriovVFResult, version QRMResultVersion) (string, error) {
+	for _, vf := range result.VFs {
+		if vf.PFName == "" || vf.VFIndex < 0 {
+			return "", fmt.Errorf("invalid vf %d of pf %q", vf.VFIndex, vf.PFName)
+		}
+	}
+	return encodeQRMResult(version, result, nil)
+}
+
+// DecodeSriovVFResult decodes the sriov vf result.
+func DecodeSriovVFResult(value string) (*SriovVFResult, error) {
+	result := &SriovVFResult{}
+	if err := decodeQRMResult(consts.PodAnnotationSriovVFResultKey, value, result, nil); err != nil {
+		return nil, err
+	}
+
+	for _, vf := range result.VFs {
+		if vf.PFName == "" || vf.VFIndex < 0 {
+			return nil, fmt.Errorf("invalid vf %d of pf %q in %s", vf.VFIndex, vf.PFName, consts.PodAnnotationSriovVFResultKey)
+		}
+	}
+	return result, nil
+}
+
+// EncodeResourcePoolResult encodes the resource pool with the given version.
+func EncodeResourcePoolResult(result *ResourcePoolResult, version QRMResultVersion) (string, error) {
+	if result.Name == "" {
+		return "", fmt.Errorf("empty resource pool name")
+	}
+	return encodeQRMResult(version, result, func() (string, error) {
+		return result.Name, nil
+	})
+}
+
+// DecodeResourcePoolResult decodes the resource pool of any version.
+func DecodeResourcePoolResult(value string) (*ResourcePoolResult, error) {
+	result := &ResourcePoolResult{}
+	err := decodeQRMResult(consts.PodAnnotationResourcePoolKey, value, result, func(value string) error {
+		result.Name = value
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Name == "" {
+		return nil, fmt.Errorf("empty name in %s", consts.PodAnnotationResourcePoolKey)
+	}
+	return result, nil
+}
+
+// EncodeResourcePackageResult encodes the resource package with the given version.
+func EncodeResourcePackageResult(result *ResourcePackageResult, version QRMResultVersion) (string, error) {
+	if result.Name == "" {
+		return "", fmt.Errorf("empty resource package name")
+	}
+	return encodeQRMResult(version, result, func() (string, error) {
+		return result.Name, nil
+	})
+}
+
+// DecodeResourcePackageResult decodes the resource package of any version.
+func DecodeResourcePackageResult(value string) (*ResourcePackageResult, error) {
+	result := &ResourcePackageResult{}
+	err := decodeQRMResult(consts.PodAnnotationResourcePackageKey, value, result, func(value string) error {
+		result.Name = value
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Name == "" {
+		return nil, fmt.Errorf("empty name in %s", consts.PodAnnotationResourcePackageKey)
+	}
+	return result, nil
+}
+
+// encodeQRMResult encodes the result with the given version, and encodeLegacy
+// is nil if the result has no legacy format.
+func encodeQRMResult(version QRMResultVersion, result interface{}, encodeLegacy func() (string, error)) (string, error) {
+	switch version {
+	case QRMResultVersionLegacy:
+		if encodeLegacy == nil {
+			return "", fmt.Errorf("legacy format is not supported")
+		}
+		return encodeLegacy()
+	case QRMResultVersionV1:
+		raw, err := json.Marshal(result)
+		if err != nil {
+			return "", err
+		}
+
+		value, err := json.Marshal(qrmResultEnvelope{Version: version, Result: raw})
+		if err != nil {
+			return "", err
+		}
+		return string(value), nil
+	default:
+		return "", fmt.Errorf("unsupported version %q", version)
+	}
+}
+
+// decodeQRMResult decodes the value into result, the value is regarded as json format if
+// it starts with '{', otherwise it's parsed by decodeLegacy which may be nil if the result
+// has no legacy format.
+func decodeQRMResult(key, value string, result interface{}, decodeLegacy func(value string) error) error {
+	value = strings.TrimSpace(value)
+	if !strings.HasPrefix(value, "{") {
+		if decodeLegacy == nil {
+			return fmt.Errorf("legacy format of %s is not supported", key)
+		}
+
+		if err := decodeLegacy(value); err != nil {
+			return fmt.Errorf("failed to parse %s %q: %v", key, value, err)
+		}
+		return nil
+	}
+
+	envelope := &qrmResultEnvelope{}
+	if err := json.Unmarshal([]byte(value), envelope); err != nil {
+		return fmt.Errorf("failed to unmarshal %s: %v", key, err)
+	}
+
+	switch envelope.Version {
+	case QRMResultVersionV1:
+		if err := json.Unmarshal(envelope.Result, result); err != nil {
+			return fmt.Errorf("failed to unmarshal %s of version %s: %v", key, envelope.Version, err)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported version %q of %s", envelope.Version, key)
+	}
+}
+
+// maxIDListID bounds the numa (or cpu) ids in id lists, and the number of ids as well, since
+// ranges in the lists are expanded and the lists may come from user-controlled annotations.
+const maxIDListID = 1024
+
+// parseIDList parses the list of ids such as "0,1" or "0-1,3" into sorted ids
+func parseIDList(value string) ([]int, error) {
+	var ids []int
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		bounds := strings.SplitN(part, "-", 2)
+		start, err := strconv.Atoi(strings.TrimSpace(bounds[0]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid id %q", part)
+		}
+
+		end := start
+		if len(bounds) == 2 {
+			end, err = strconv.Atoi(strings.TrimSpace(bounds[1]))
+			if err != nil || end < start {
+				return nil, fmt.Errorf("invalid id range %q", part)
+			}
+		}
+
+		if start < 0 || end >= maxIDListID {
+			return nil, fmt.Errorf("id range %q exceeds [0, %d)", part, maxIDListID)
+		} else if len(ids)+end-start+1 > maxIDListID {
+			return nil, fmt.Errorf("id list %q has more than %d ids", value, maxIDListID)
+		}
+
+		for id := start; id <= end; id++ {
+			ids = append(ids, id)
+		}
+	}
+
+	sort.Ints(ids)
+	return ids, nil
+}
+
+// formatIDList formats the ids in the form of "0,1"
+func formatIDList(ids []int) string {
+	parts := make([]string, 0, len(ids))
+	for _, id := range ids {
+		parts = append(parts, strconv.Itoa(id))
+	}
+	return strings.Join(parts, ",")
+}
+
+// validateIDList checks that the ids are in [0, maxIDListID), sorted and without duplication
+func validateIDList(ids []int) error {
+	for i, id := range ids {
+		if id < 0 || id >= maxIDListID {
+			return fmt.Errorf("id %d exceeds [0, %d)", id, maxIDListID)
+		}
+		if i > 0 && id <= ids[i-1] {
+			return fmt.Errorf("ids %v are not sorted or have duplication", ids)
+		}
+	}
+	return nil
+}
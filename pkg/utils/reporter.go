@@ -0,0 +1,186 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	nodev1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/node/v1alpha1"
+	"github.com/kubewharf/katalyst-api/pkg/protocol/reporterplugin/v1alpha1"
+)
+
+// ReportContentRule declares which fields of which objects a reporter plugin is allowed to write.
+type ReportContentRule struct {
+	// GroupVersionKind is the kind of the target objects.
+	GroupVersionKind metav1.GroupVersionKind
+
+	// FieldType is the type of the target fields.
+	FieldType v1alpha1.FieldType
+
+	// FieldNames are the names of the allowed fields, empty means all fields are allowed.
+	FieldNames []string
+
+	// AllowObjectReference indicates whether the report content can refer to objects other than
+	// the node-level one (i.e. the object with the same name as current node) by ObjectReference.
+	AllowObjectReference bool
+
+	// Namespaces are the allowed namespaces of the referred objects, empty means all namespaces
+	// are allowed; it only takes effect when AllowObjectReference is true.
+	Namespaces []string
+}
+
+// DefaultReportContentRules allows to write all fields of the node-level CustomNodeResource,
+// which is the same as the behavior before object reference was introduced.
+var DefaultReportContentRules = []ReportContentRule{
+	{
+		GroupVersionKind: metav1.GroupVersionKind(nodev1alpha1.SchemeGroupVersion.WithKind("CustomNodeResource")),
+		FieldType:        v1alpha1.FieldType_Spec,
+	},
+	{
+		GroupVersionKind: metav1.GroupVersionKind(nodev1alpha1.SchemeGroupVersion.WithKind("CustomNodeResource")),
+		FieldType:        v1alpha1.FieldType_Status,
+	},
+	{
+		GroupVersionKind: metav1.GroupVersionKind(nodev1alpha1.SchemeGroupVersion.WithKind("CustomNodeResource")),
+		FieldType:        v1alpha1.FieldType_Metadata,
+	},
+}
+
+// allows checks whether the given field of the given object matches this rule.
+func (r ReportContentRule) allows(gvk metav1.GroupVersionKind, ref *v1alpha1.ObjectReference, field *v1alpha1.ReportField) bool {
+	if r.GroupVersionKind != gvk || r.FieldType != field.FieldType {
+		return false
+	}
+
+	if len(r.FieldNames) > 0 && !sets.NewString(r.FieldNames...).Has(field.FieldName) {
+		return false
+	}
+
+	if !isNodeLevelReportTarget(ref) {
+		if !r.AllowObjectReference {
+			return false
+		}
+
+		if len(r.Namespaces) > 0 && !sets.NewString(r.Namespaces...).Has(ref.Namespace) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ReportContentAllowList maintains the report content rules for each reporter plugin,
+// and the agent should use it to filter out contents that the plugin is not allowed to write.
+type ReportContentAllowList struct {
+	defaultRules []ReportContentRule
+	pluginRules  map[string][]ReportContentRule
+}
+
+// NewReportContentAllowList returns an allow list with the given rules, and plugins
+// not included in pluginRules will fall back to use defaultRules.
+func NewReportContentAllowList(defaultRules []ReportContentRule, pluginRules map[string][]ReportContentRule) *ReportContentAllowList {
+	if pluginRules == nil {
+		pluginRules = make(map[string][]ReportContentRule)
+	}
+
+	return &ReportContentAllowList{
+		defaultRules: defaultRules,
+		pluginRules:  pluginRules,
+	}
+}
+
+// GetRules returns the rules that take effect for the given plugin.
+func (l *ReportContentAllowList) GetRules(pluginName string) []ReportContentRule {
+	if rules, ok := l.pluginRules[pluginName]; ok {
+		return rules
+	}
+	return l.defaultRules
+}
+
+// Filter returns the report contents that the given plugin is allowed to write, and
+// an aggregated error describing all the denied fields if any.
+func (l *ReportContentAllowList) Filter(pluginName string, contents []*v1alpha1.ReportContent) ([]*v1alpha1.ReportContent, error) {
+	rules := l.GetRules(pluginName)
+
+	var errList []error
+	filtered := make([]*v1alpha1.ReportContent, 0, len(contents))
+	for _, content := range contents {
+		if content == nil {
+			continue
+		}
+
+		if content.GroupVersionKind == nil {
+			errList = append(errList, fmt.Errorf("plugin %s reports content without gvk", pluginName))
+			continue
+		}
+
+		allowed := &v1alpha1.ReportContent{
+			GroupVersionKind: content.GroupVersionKind,
+			ObjectReference:  content.ObjectReference,
+		}
+		for _, field := range content.Field {
+			if field == nil {
+				continue
+			}
+
+			if l.allows(rules, *content.GroupVersionKind, content.ObjectReference, field) {
+				allowed.Field = append(allowed.Field, field)
+			} else {
+				errList = append(errList, fmt.Errorf("plugin %s is not allowed to write %v field %s of %s",
+					pluginName, field.FieldType, field.FieldName, describeReportTarget(*content.GroupVersionKind, content.ObjectReference)))
+			}
+		}
+
+		if len(allowed.Field) > 0 {
+			filtered = append(filtered, allowed)
+		}
+	}
+
+	return filtered, utilerrors.NewAggregate(errList)
+}
+
+// Validate checks whether all the report contents are allowed to be written by the given plugin.
+func (l *ReportContentAllowList) Validate(pluginName string, contents []*v1alpha1.ReportContent) error {
+	_, err := l.Filter(pluginName, contents)
+	return err
+}
+
+func (l *ReportContentAllowList) allows(rules []ReportContentRule, gvk metav1.GroupVersionKind,
+	ref *v1alpha1.ObjectReference, field *v1alpha1.ReportField) bool {
+	for _, rule := range rules {
+		if rule.allows(gvk, ref, field) {
+			return true
+		}
+	}
+	return false
+}
+
+// isNodeLevelReportTarget returns true if the object reference refers to the node-level object.
+func isNodeLevelReportTarget(ref *v1alpha1.ObjectReference) bool {
+	return ref.GetNamespace() == "" && ref.GetName() == ""
+}
+
+func describeReportTarget(gvk metav1.GroupVersionKind, ref *v1alpha1.ObjectReference) string {
+	if isNodeLevelReportTarget(ref) {
+		return fmt.Sprintf("node-level %s", gvk.String())
+	}
+	return fmt.Sprintf("%s %s/%s", gvk.String(), ref.Namespace, ref.Name)
+}
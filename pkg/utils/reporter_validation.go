@@ -0,0 +1,285 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	sigsjson "sigs.k8s.io/json"
+
+	nodev1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/node/v1alpha1"
+	workloadv1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/workload/v1alpha1"
+	"github.com/kubewharf/katalyst-api/pkg/protocol/reporterplugin/v1alpha1"
+)
+
+// maxReportValueLengthInError is the max length of report value shown in error messages
+const maxReportValueLengthInError = 64
+
+// reportFieldTypeRoots maps report field type to the json name of the corresponding part in objects.
+var reportFieldTypeRoots = map[v1alpha1.FieldType]string{
+	v1alpha1.FieldType_Spec:     "spec",
+	v1alpha1.FieldType_Status:   "status",
+	v1alpha1.FieldType_Metadata: "metadata",
+}
+
+// ReportContentValidator validates report contents against the go struct of the target gvk,
+// and the value is decoded strictly. The canonical field name is the go struct field name (e.g.
+// TopologyZone) as used by reporter manager to set fields, and the json name (e.g. topologyZone)
+// is only accepted as a fallback for compatibility.
+type ReportContentValidator struct {
+	scheme *runtime.Scheme
+}
+
+// NewReportContentValidator returns a validator which creates target objects by the given scheme.
+func NewReportContentValidator(scheme *runtime.Scheme) *ReportContentValidator {
+	return &ReportContentValidator{scheme: scheme}
+}
+
+// NewDefaultReportContentValidator returns a validator which supports kubernetes core
+// types and katalyst node and workload types.
+func NewDefaultReportContentValidator() *ReportContentValidator {
+	scheme := runtime.NewScheme()
+	_ = v1.AddToScheme(scheme)
+	_ = nodev1alpha1.AddToScheme(scheme)
+	_ = workloadv1alpha1.AddToScheme(scheme)
+	return NewReportContentValidator(scheme)
+}
+
+// Validate checks all the report contents, and returns the aggregated error if any.
+func (v *ReportContentValidator) Validate(contents []*v1alpha1.ReportContent) error {
+	var errList field.ErrorList
+	for i, content := range contents {
+		errList = append(errList, v.ValidateContent(field.NewPath("content").Index(i), content)...)
+	}
+	return errList.ToAggregate()
+}
+
+// ValidateContent checks a single report content, and errors are reported with the
+// field path of the target object, such as content[0].status.topologyZone[0].type.
+func (v *ReportContentValidator) ValidateContent(fldPath *field.Path, content *v1alpha1.ReportContent) field.ErrorList {
+	if content == nil {
+		return nil
+	}
+
+	if content.GroupVersionKind == nil {
+		return field.ErrorList{field.Required(fldPath.Child("groupVersionKind"), "")}
+	}
+
+	gvk := schema.GroupVersionKind(*content.GroupVersionKind)
+	obj, err := v.scheme.New(gvk)
+	if err != nil {
+		return field.ErrorList{field.Invalid(fldPath.Child("groupVersionKind"), gvk.String(), err.Error())}
+	}
+
+	var errList field.ErrorList
+	for _, reportField := range content.Field {
+		if reportField == nil {
+			continue
+		}
+		errList = append(errList, validateReportField(fldPath, obj, reportField)...)
+	}
+	return errList
+}
+
+func validateReportField(fldPath *field.Path, obj runtime.Object, reportField *v1alpha1.ReportField) field.ErrorList {
+	root, ok := reportFieldTypeRoots[reportField.FieldType]
+	if !ok {
+		return field.ErrorList{field.NotSupported(fldPath.Child("fieldType"), reportField.FieldType.String(),
+			[]string{v1alpha1.FieldType_Spec.String(), v1alpha1.FieldType_Status.String(), v1alpha1.FieldType_Metadata.String()})}
+	}
+
+	rootPath := fldPath.Child(root)
+	rootType, ok := jsonFieldTypes(reflect.TypeOf(obj))[root]
+	if !ok {
+		return field.ErrorList{field.NotSupported(fldPath.Child("fieldType"), reportField.FieldType.String(), nil)}
+	}
+
+	jsonName, fieldType, ok := resolveReportField(rootType, reportField.FieldName)
+	if !ok {
+		return field.ErrorList{field.NotSupported(rootPath, reportField.FieldName, goFieldNames(rootType))}
+	}
+
+	fieldPath := rootPath.Child(jsonName)
+	if err := json.Unmarshal(reportField.Value, reflect.New(fieldType).Interface()); err != nil {
+		if typeErr, ok := err.(*json.UnmarshalTypeError); ok {
+			return field.ErrorList{field.Invalid(childPath(fieldPath, typeErr.Field), typeErr.Value,
+				fmt.Sprintf("cannot be decoded into %v", typeErr.Type))}
+		}
+		return field.ErrorList{field.Invalid(fieldPath, truncateReportValue(reportField.Value), err.Error())}
+	}
+
+	// encoding/json is case-insensitive and ignores unknown fields, so we use sigs.k8s.io/json
+	// to detect them, and the returned errors contain the full path of the unknown fields.
+	strictErrs, err := sigsjson.UnmarshalStrict(reportField.Value, reflect.New(fieldType).Interface())
+	if err != nil {
+		return field.ErrorList{field.Invalid(fieldPath, truncateReportValue(reportField.Value), err.Error())}
+	}
+
+	var errList field.ErrorList
+	for _, strictErr := range strictErrs {
+		errList = append(errList, convertStrictError(fieldPath, strictErr))
+	}
+	return errList
+}
+
+// convertStrictError converts the strict error returned by sigs.k8s.io/json, whose message
+// looks like `unknown field "[0].allocations[0].foo"`, into field error with full path.
+func convertStrictError(fldPath *field.Path, strictErr error) *field.Error {
+	msg := strictErr.Error()
+	quoted := strings.Index(msg, "\"")
+	if quoted < 0 {
+		return field.Invalid(fldPath, "", msg)
+	}
+
+	relativePath, err := strconv.Unquote(msg[quoted:])
+	if err != nil {
+		return field.Invalid(fldPath, "", msg)
+	}
+
+	errPath := childPath(fldPath, relativePath)
+	switch strings.TrimSpace(msg[:quoted]) {
+	case "unknown field":
+		return field.Forbidden(errPath, "unknown field")
+	case "duplicate field":
+		return field.Duplicate(errPath, relativePath)
+	default:
+		return field.Invalid(errPath, "", msg)
+	}
+}
+
+// resolveReportField returns the json name and type of the field in the given struct type by its go
+// field name, falling back to its json name, and promoted fields of embedded structs are included.
+func resolveReportField(t reflect.Type, name string) (string, reflect.Type, bool) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return "", nil, false
+	}
+
+	if f, ok := t.FieldByName(name); ok && f.PkgPath == "" && !f.Anonymous {
+		tag := f.Tag.Get("json")
+		if tag != "-" {
+			jsonName := strings.Split(tag, ",")[0]
+			if jsonName == "" {
+				jsonName = f.Name
+			}
+			return jsonName, f.Type, true
+		}
+	}
+
+	fieldType, ok := jsonFieldTypes(t)[name]
+	return name, fieldType, ok
+}
+
+// goFieldNames returns the sorted go names of all the fields that can be reported in the given
+// struct type (or pointer to struct), and fields of embedded structs are also included.
+func goFieldNames(t reflect.Type) []string {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var names []string
+	for _, f := range reflect.VisibleFields(t) {
+		if f.PkgPath != "" || f.Anonymous || f.Tag.Get("json") == "-" {
+			continue
+		}
+		names = append(names, f.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// jsonFieldTypes returns the types of all the fields of the given struct type (or pointer to struct)
+// by their json names, and fields of inline embedded structs are also included.
+func jsonFieldTypes(t reflect.Type) map[string]reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	result := make(map[string]reflect.Type)
+	if t.Kind() != reflect.Struct {
+		return result
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" && !f.Anonymous {
+			continue
+		}
+
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name := strings.Split(tag, ",")[0]
+		if name == "" && f.Anonymous {
+			for embeddedName, embeddedType := range jsonFieldTypes(f.Type) {
+				if _, ok := result[embeddedName]; !ok {
+					result[embeddedName] = embeddedType
+				}
+			}
+			continue
+		}
+
+		if name == "" {
+			name = f.Name
+		}
+		result[name] = f.Type
+	}
+
+	return result
+}
+
+// truncateReportValue limits the length of value shown in error messages.
+func truncateReportValue(value []byte) string {
+	if len(value) > maxReportValueLengthInError {
+		return string(value[:maxReportValueLengthInError]) + "..."
+	}
+	return string(value)
+}
+
+// childPath appends the relative path to the given path, and the relative path may be either
+// dot-separated like `0.children.1.type` or with brackets like `[0].children[1].type`.
+func childPath(fldPath *field.Path, relativePath string) *field.Path {
+	relativePath = strings.NewReplacer("[", ".", "]", "").Replace(relativePath)
+	for _, name := range strings.Split(relativePath, ".") {
+		if name == "" {
+			continue
+		}
+
+		if index, err := strconv.Atoi(name); err == nil {
+			fldPath = fldPath.Index(index)
+		} else {
+			fldPath = fldPath.Child(name)
+		}
+	}
+	return fldPath
+}
@@ -0,0 +1,432 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	nodev1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/node/v1alpha1"
+	"github.com/kubewharf/katalyst-api/pkg/consts"
+)
+
+// DefaultResourcePackageName is the package that pods without resource package annotation fall back to,
+// and its variants named "default-N" (N = integer) are default packages as well.
+const DefaultResourcePackageName = "default"
+
+// ResourcePoolUsage is the accounting result of a resource pool.
+type ResourcePoolUsage struct {
+	PoolName string
+	// Min and Max are copied from the pool, and a resource missing from Max is not capped by the pool.
+	Min v1.ResourceList
+	Max v1.ResourceList
+	// Requested is the total requests of pods in the pool.
+	Requested v1.ResourceList
+	// Available is the amount a new pod in the pool can still request, which is the unused
+	// part of Min plus the shared resources it can borrow, capped by the unused part of Max;
+	// a resource missing from Available is not limited.
+	Available v1.ResourceList
+	// Overflow is the amount Requested exceeds Max.
+	Overflow v1.ResourceList
+	// Pods are the pods in the pool in format of {namespace}/{name}.
+	Pods []string
+}
+
+// ResourcePackageUsage is the accounting result of a resource package.
+type ResourcePackageUsage struct {
+	PackageName string
+	Allocatable v1.ResourceList
+	// Requested is the total requests of pods in the package.
+	Requested v1.ResourceList
+	// Available is the unused part of Allocatable, and it's never negative.
+	Available v1.ResourceList
+	// Overflow is the amount Requested exceeds Allocatable.
+	Overflow v1.ResourceList
+	// Pods are the pods in the package in format of {namespace}/{name}.
+	Pods []string
+}
+
+// ResourcePoolAccounting computes the usage of resource pools and resource packages of a node from
+// the requests of its pods, so that the scheduler and the admission webhook share the same semantics.
+//
+// Pools are elastic: MinAllocatable of all pools is reserved from the node allocatable, and the rest
+// is shared by pods out of any pool and pools requesting more than their MinAllocatable, while each
+// pool is still capped by its MaxAllocatable. Packages are fixed subdivisions of the node, and pods
+// without package annotation fall back to the first default package ("default" before "default-N"
+// in the order of N) with enough resources left, or the first one if none has.
+type ResourcePoolAccounting struct {
+	Pools    map[string]*ResourcePoolUsage
+	Packages map[string]*ResourcePackageUsage
+
+	// SharedAvailable is the node allocatable not reserved by pools nor requested by pods out of
+	// pools or borrowed by pools, and it's nil if the node allocatable is unknown.
+	SharedAvailable v1.ResourceList
+	// SharedOverflow is the amount of shared resources requested beyond the node allocatable.
+	SharedOverflow v1.ResourceList
+
+	// UnknownPoolPods and UnknownPackagePods are pods referring to pools or packages not in the cnr,
+	// and pods of unknown pools are accounted as pods out of any pool.
+	UnknownPoolPods    []string
+	UnknownPackagePods []string
+	// UnboundPackagePods are pods without package annotation when no default package exists.
+	UnboundPackagePods []string
+
+	// defaultPackages are the default packages in the order pods fall back to them
+	defaultPackages []*ResourcePackageUsage
+}
+
+// NewResourcePoolAccounting computes the usage of resource pools and packages in the cnr, and pods
+// which have finished or are not bound to the node of the cnr are ignored. Pool and package names
+// are decoded from annotations of any version.
+func NewResourcePoolAccounting(cnr *nodev1alpha1.CustomNodeResource, pods []*v1.Pod) *ResourcePoolAccounting {
+	a := &ResourcePoolAccounting{
+		Pools:    make(map[string]*ResourcePoolUsage),
+		Packages: make(map[string]*ResourcePackageUsage),
+	}
+
+	resources := cnr.Status.Resources
+	for _, pool := range resources.ResourcePools {
+		a.Pools[pool.PoolName] = &ResourcePoolUsage{
+			PoolName:  pool.PoolName,
+			Min:       copyResourceList(pool.MinAllocatable),
+			Max:       copyResourceList(pool.MaxAllocatable),
+			Requested: v1.ResourceList{},
+		}
+	}
+	for _, pkg := range resources.ResourcePackages {
+		usage := &ResourcePackageUsage{
+			PackageName: pkg.PackageName,
+			Allocatable: copyResourceList(pkg.Allocatable),
+			Requested:   v1.ResourceList{},
+		}
+		a.Packages[pkg.PackageName] = usage
+		if isDefaultResourcePackage(pkg.PackageName) {
+			a.defaultPackages = append(a.defaultPackages, usage)
+		}
+	}
+	sort.Slice(a.defaultPackages, func(i, j int) bool {
+		return defaultResourcePackageIndex(a.defaultPackages[i].PackageName) <
+			defaultResourcePackageIndex(a.defaultPackages[j].PackageName)
+	})
+
+	var accounted []*v1.Pod
+	for _, pod := range pods {
+		if pod != nil && pod.Status.Phase != v1.PodSucceeded && pod.Status.Phase != v1.PodFailed &&
+			pod.Spec.NodeName == cnr.Name {
+			accounted = append(accounted, pod)
+		}
+	}
+	// default packages are assigned in order, so pods are sorted by creation time and then by
+	// namespace and name to get the same result regardless of the order of the given pods
+	sort.SliceStable(accounted, func(i, j int) bool {
+		if !accounted[i].CreationTimestamp.Equal(&accounted[j].CreationTimestamp) {
+			return accounted[i].CreationTimestamp.Before(&accounted[j].CreationTimestamp)
+		}
+		return accounted[i].Namespace+"/"+accounted[i].Name < accounted[j].Namespace+"/"+accounted[j].Name
+	})
+
+	outOfPool := v1.ResourceList{}
+	for _, pod := range accounted {
+		key := pod.Namespace + "/" + pod.Name
+		requests := PodResourceRequests(pod)
+
+		if pool, ok := a.poolOf(pod); ok {
+			addResourceList(pool.Requested, requests)
+			pool.Pods = append(pool.Pods, key)
+		} else {
+			if podResourcePoolName(pod) != "" {
+				a.UnknownPoolPods = append(a.UnknownPoolPods, key)
+			}
+			addResourceList(outOfPool, requests)
+		}
+
+		if pkg, ok := a.packageOf(pod, requests); ok {
+			addResourceList(pkg.Requested, requests)
+			pkg.Pods = append(pkg.Pods, key)
+		} else if podResourcePackageName(pod) != "" {
+			a.UnknownPackagePods = append(a.UnknownPackagePods, key)
+		} else {
+			a.UnboundPackagePods = append(a.UnboundPackagePods, key)
+		}
+	}
+
+	a.computeShared(resources.Allocatable, outOfPool)
+	for _, pool := range a.Pools {
+		a.computePool(pool)
+	}
+	for _, pkg := range a.Packages {
+		pkg.Available, pkg.Overflow = v1.ResourceList{}, v1.ResourceList{}
+		for name, allocatable := range pkg.Allocatable {
+			pkg.Available[name] = nonNegative(subQuantity(allocatable, pkg.Requested[name]))
+			if overflow := subQuantity(pkg.Requested[name], allocatable); overflow.Sign() > 0 {
+				pkg.Overflow[name] = overflow
+			}
+		}
+	}
+	return a
+}
+
+// Fit returns nil if the pod fits in its resource pool and resource package, or the shared
+// resources of the node if it's out of any pool; the pod itself must not be accounted yet.
+func (a *ResourcePoolAccounting) Fit(pod *v1.Pod) error {
+	requests := PodResourceRequests(pod)
+
+	if pool, ok := a.poolOf(pod); ok {
+		if insufficient := insufficientResources(requests, pool.Available); len(insufficient) > 0 {
+			return fmt.Errorf("insufficient %s in resource pool %s", strings.Join(insufficient, ", "), pool.PoolName)
+		}
+	} else if name := podResourcePoolName(pod); name != "" {
+		return fmt.Errorf("resource pool %s is not found", name)
+	} else if insufficient := insufficientResources(requests, a.SharedAvailable); len(insufficient) > 0 {
+		return fmt.Errorf("insufficient shared %s out of resource pools", strings.Join(insufficient, ", "))
+	}
+
+	if pkg, ok := a.packageOf(pod, requests); ok {
+		if insufficient := insufficientResources(requests, pkg.Available); len(insufficient) > 0 {
+			return fmt.Errorf("insufficient %s in resource package %s", strings.Join(insufficient, ", "), pkg.PackageName)
+		}
+	} else if name := podResourcePackageName(pod); name != "" {
+		return fmt.Errorf("resource package %s is not found", name)
+	}
+	return nil
+}
+
+// PodResourceRequests returns the effective requests of the pod, which is the larger one of the
+// sum of containers and any init container, plus the pod overhead.
+func PodResourceRequests(pod *v1.Pod) v1.ResourceList {
+	result := v1.ResourceList{}
+	for _, container := range pod.Spec.Containers {
+		addResourceList(result, container.Resources.Requests)
+	}
+
+	for _, container := range pod.Spec.InitContainers {
+		for name, quantity := range container.Resources.Requests {
+			if current, ok := result[name]; !ok || quantity.Cmp(current) > 0 {
+				result[name] = quantity.DeepCopy()
+			}
+		}
+	}
+
+	addResourceList(result, pod.Spec.Overhead)
+	return result
+}
+
+func (a *ResourcePoolAccounting) poolOf(pod *v1.Pod) (*ResourcePoolUsage, bool) {
+	pool, ok := a.Pools[podResourcePoolName(pod)]
+	return pool, ok
+}
+
+// packageOf returns the package of the pod, and pods without package annotation fall back to the
+// first default package where the requests fit, or the first default package if none fits.
+func (a *ResourcePoolAccounting) packageOf(pod *v1.Pod, requests v1.ResourceList) (*ResourcePackageUsage, bool) {
+	if name := podResourcePackageName(pod); name != "" {
+		pkg, ok := a.Packages[name]
+		return pkg, ok
+	}
+
+	for _, pkg := range a.defaultPackages {
+		fits := true
+		for name, quantity := range requests {
+			allocatable, ok := pkg.Allocatable[name]
+			if !ok {
+				continue
+			}
+			if left := subQuantity(allocatable, pkg.Requested[name]); left.Cmp(quantity) < 0 {
+				fits = false
+				break
+			}
+		}
+		if fits {
+			return pkg, true
+		}
+	}
+
+	if len(a.defaultPackages) > 0 {
+		return a.defaultPackages[0], true
+	}
+	return nil, false
+}
+
+// podResourcePoolName returns the resource pool name in the annotation of any version, and
+// the raw annotation is returned if it can't be decoded, so that it's treated as unknown.
+func podResourcePoolName(pod *v1.Pod) string {
+	value := pod.Annotations[consts.PodAnnotationResourcePoolKey]
+	if value == "" {
+		return ""
+	}
+
+	result, err := DecodeResourcePoolResult(value)
+	if err != nil {
+		return value
+	}
+	return result.Name
+}
+
+// podResourcePackageName returns the resource package name in the annotation of any version, and
+// the raw annotation is returned if it can't be decoded, so that it's treated as unknown.
+func podResourcePackageName(pod *v1.Pod) string {
+	value := pod.Annotations[consts.PodAnnotationResourcePackageKey]
+	if value == "" {
+		return ""
+	}
+
+	result, err := DecodeResourcePackageResult(value)
+	if err != nil {
+		return value
+	}
+	return result.Name
+}
+
+// isDefaultResourcePackage returns whether the package is a default package, which is
+// named "default" or "default-N" (N = integer).
+func isDefaultResourcePackage(name string) bool {
+	return defaultResourcePackageIndex(name) >= 0
+}
+
+// defaultResourcePackageIndex returns 0 for "default", N+1 for "default-N", and -1 for
+// packages which are not default packages.
+func defaultResourcePackageIndex(name string) int {
+	if name == DefaultResourcePackageName {
+		return 0
+	}
+
+	suffix := strings.TrimPrefix(name, DefaultResourcePackageName+"-")
+	if suffix == name || suffix == "" || strings.Trim(suffix, "0123456789") != "" {
+		return -1
+	}
+
+	index, err := strconv.Atoi(suffix)
+	if err != nil {
+		return -1
+	}
+	return index + 1
+}
+
+// computeShared computes the shared resources, which is the node allocatable minus the
+// reservation of all pools, requests out of pools and requests of pools beyond their min.
+func (a *ResourcePoolAccounting) computeShared(allocatable *v1.ResourceList, outOfPool v1.ResourceList) {
+	if allocatable == nil {
+		return
+	}
+
+	a.SharedAvailable, a.SharedOverflow = v1.ResourceList{}, v1.ResourceList{}
+	for name, quantity := range *allocatable {
+		shared := subQuantity(quantity, outOfPool[name])
+		for _, pool := range a.Pools {
+			reserved := pool.Min[name]
+			if requested := pool.Requested[name]; requested.Cmp(reserved) > 0 {
+				reserved = requested
+			}
+			shared.Sub(reserved)
+		}
+
+		if shared.Sign() < 0 {
+			a.SharedOverflow[name] = subQuantity(resource.Quantity{}, shared)
+		}
+		a.SharedAvailable[name] = nonNegative(shared)
+	}
+}
+
+func (a *ResourcePoolAccounting) computePool(pool *ResourcePoolUsage) {
+	pool.Available, pool.Overflow = v1.ResourceList{}, v1.ResourceList{}
+	for _, name := range resourceNames(pool.Min, pool.Max, pool.Requested, a.SharedAvailable) {
+		requested := pool.Requested[name]
+		maxQuantity, capped := pool.Max[name]
+		if capped {
+			if overflow := subQuantity(requested, maxQuantity); overflow.Sign() > 0 {
+				pool.Overflow[name] = overflow
+			}
+		}
+
+		shared, limited := a.SharedAvailable[name]
+		if !capped && !limited {
+			continue
+		}
+
+		var available resource.Quantity
+		if limited {
+			available = nonNegative(subQuantity(pool.Min[name], requested))
+			available.Add(shared)
+		}
+		if capped {
+			if remaining := nonNegative(subQuantity(maxQuantity, requested)); !limited || remaining.Cmp(available) < 0 {
+				available = remaining
+			}
+		}
+		pool.Available[name] = available
+	}
+}
+
+// insufficientResources returns the sorted names of requested resources beyond available,
+// and resources missing from available are not limited.
+func insufficientResources(requests, available v1.ResourceList) []string {
+	var names []string
+	for name, quantity := range requests {
+		if limit, ok := available[name]; ok && quantity.Cmp(limit) > 0 {
+			names = append(names, string(name))
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func resourceNames(lists ...v1.ResourceList) []v1.ResourceName {
+	seen := make(map[v1.ResourceName]bool)
+	var names []v1.ResourceName
+	for _, list := range lists {
+		for name := range list {
+			if !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	return names
+}
+
+func copyResourceList(list *v1.ResourceList) v1.ResourceList {
+	if list == nil {
+		return nil
+	}
+	return list.DeepCopy()
+}
+
+func addResourceList(dst, src v1.ResourceList) {
+	for name, quantity := range src {
+		sum := dst[name]
+		sum.Add(quantity)
+		dst[name] = sum
+	}
+}
+
+func subQuantity(a, b resource.Quantity) resource.Quantity {
+	result := a.DeepCopy()
+	result.Sub(b)
+	return result
+}
+
+func nonNegative(q resource.Quantity) resource.Quantity {
+	if q.Sign() < 0 {
+		return resource.Quantity{Format: q.Format}
+	}
+	return q
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	sigsjson "sigs.k8s.io/json"
+
+	workloadv1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/workload/v1alpha1"
+)
+
+// ValidateServiceProfileDescriptor validates the spd for admission. Indicators of registered extended
+// indicators are decoded strictly against their types; in strict mode, extended indicators not
+// registered are rejected, otherwise they are left to out-of-tree consumers.
+func ValidateServiceProfileDescriptor(spd *workloadv1alpha1.ServiceProfileDescriptor, strict bool) field.ErrorList {
+	specPath := field.NewPath("spec")
+
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, validateBaselinePercent(spd.Spec.BaselinePercent, specPath.Child("baselinePercent"))...)
+
+	names := sets.NewString()
+	for i := range spd.Spec.ExtendedIndicator {
+		indicator := &spd.Spec.ExtendedIndicator[i]
+		indicatorPath := specPath.Child("extendedIndicator").Index(i)
+
+		if indicator.Name == "" {
+			allErrs = append(allErrs, field.Required(indicatorPath.Child("name"), ""))
+		} else if names.Has(indicator.Name) {
+			allErrs = append(allErrs, field.Duplicate(indicatorPath.Child("name"), indicator.Name))
+		}
+		names.Insert(indicator.Name)
+
+		allErrs = append(allErrs, validateBaselinePercent(indicator.BaselinePercent, indicatorPath.Child("baselinePercent"))...)
+		allErrs = append(allErrs, validateExtendedIndicators(indicator, indicatorPath, strict)...)
+	}
+	return allErrs
+}
+
+func validateExtendedIndicators(indicator *workloadv1alpha1.ServiceExtendedIndicatorSpec, fldPath *field.Path,
+	strict bool,
+) field.ErrorList {
+	obj, ok := workloadv1alpha1.NewExtendedIndicators(indicator.Name)
+	if !ok {
+		if strict && indicator.Name != "" {
+			return field.ErrorList{field.NotSupported(fldPath.Child("name"), indicator.Name,
+				workloadv1alpha1.RegisteredExtendedIndicators())}
+		}
+		return nil
+	}
+
+	if len(indicator.Indicators.Raw) == 0 {
+		return nil
+	}
+
+	indicatorsPath := fldPath.Child("indicators")
+	strictErrs, err := sigsjson.UnmarshalStrict(indicator.Indicators.Raw, obj)
+	if err != nil {
+		return field.ErrorList{field.Invalid(indicatorsPath, truncateReportValue(indicator.Indicators.Raw), err.Error())}
+	}
+
+	var allErrs field.ErrorList
+	for _, strictErr := range strictErrs {
+		allErrs = append(allErrs, convertStrictError(indicatorsPath, strictErr))
+	}
+	return allErrs
+}
+
+func validateBaselinePercent(percent *int32, fldPath *field.Path) field.ErrorList {
+	if percent != nil && (*percent < 0 || *percent > 100) {
+		return field.ErrorList{field.Invalid(fldPath, *percent, "must be between 0 and 100")}
+	}
+	return nil
+}
@@ -0,0 +1,565 @@
+/*
+Copyright 2022 The Katalyst Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/kubernetes/pkg/apis/core/v1/helper/qos"
+	kubeschedulerconfig "k8s.io/kubernetes/pkg/scheduler/apis/config"
+
+	nodev1alpha1 "github.com/kubewharf/katalyst-api/pkg/apis/node/v1alpha1"
+	"github.com/kubewharf/katalyst-api/pkg/apis/node/v1alpha1/topology"
+	"github.com/kubewharf/katalyst-api/pkg/apis/scheduling/config"
+	"github.com/kubewharf/katalyst-api/pkg/consts"
+)
+
+const (
+	// maxTopologyScore is the same as the max node score of scheduler framework
+	maxTopologyScore int64 = 100
+	// maxCustomTopologyScore is the max score in the shape of RequestedToCapacityRatio
+	maxCustomTopologyScore int64 = 10
+)
+
+var defaultTopologyAlignedResources = []v1.ResourceName{v1.ResourceCPU, v1.ResourceMemory}
+
+// topologyPolicyKind is the topology manager policy regardless of the scope
+type topologyPolicyKind int
+
+const (
+	topologyPolicyKindNone topologyPolicyKind = iota
+	topologyPolicyKindSingleNUMANode
+	topologyPolicyKindRestricted
+	topologyPolicyKindBestEffort
+	topologyPolicyKindNumeric
+)
+
+// TopologyFitResult is the result of TopologyFitter.Fit.
+type TopologyFitResult struct {
+	Fit bool
+	// Reason explains why the pod doesn't fit.
+	Reason string
+	// NUMANodes are the sorted ids of numa nodes the pod would be bound to, and it's empty if the
+	// pod needs no alignment or the policy can't find a set of numa nodes but still admits the pod.
+	NUMANodes []int
+	// ContainerNUMANodes are numa nodes of each container for container level policies.
+	ContainerNUMANodes map[string][]int
+}
+
+// TopologyFitter decides whether a pod fits in the numa nodes of a cnr under its TopologyPolicy,
+// and scores the result by the scoring strategies of the NodeResourceTopologyMatch plugin args, so that
+// simulation tools can reuse it without running the scheduler. The scores approximate those of the
+// plugin in katalyst-core and are not guaranteed to be identical.
+//
+// Only pods which need alignment are checked: dedicated_cores pods with numa binding for the dynamic
+// resource plugin policy, or guaranteed pods with integer cpu requests for the native and static ones.
+// Free resources of a numa node are its allocatable minus allocations in the cnr, and only the aligned
+// resources are considered.
+type TopologyFitter struct {
+	policy           consts.ResourcePluginPolicyName
+	alignedResources []v1.ResourceName
+	scoringStrategy  *config.ScoringStrategy
+}
+
+// NewTopologyFitter returns a fitter with the plugin args, and defaults are applied for the empty fields.
+func NewTopologyFitter(args *config.NodeResourceTopologyArgs) *TopologyFitter {
+	f := &TopologyFitter{
+		policy:           args.ResourcePluginPolicy,
+		alignedResources: defaultTopologyAlignedResources,
+		scoringStrategy:  args.ScoringStrategy,
+	}
+	if f.policy == "" {
+		f.policy = consts.ResourcePluginPolicyNameNative
+	}
+
+	if len(args.AlignedResources) > 0 {
+		f.alignedResources = make([]v1.ResourceName, 0, len(args.AlignedResources))
+		for _, name := range args.AlignedResources {
+			f.alignedResources = append(f.alignedResources, v1.ResourceName(name))
+		}
+	}
+
+	if f.scoringStrategy == nil {
+		f.scoringStrategy = &config.ScoringStrategy{Type: kubeschedulerconfig.LeastAllocated}
+	}
+	if len(f.scoringStrategy.Resources) == 0 {
+		strategy := *f.scoringStrategy
+		strategy.Resources = []kubeschedulerconfig.ResourceSpec{
+			{Name: string(v1.ResourceCPU), Weight: 1},
+			{Name: string(v1.ResourceMemory), Weight: 1},
+		}
+		f.scoringStrategy = &strategy
+	}
+	return f
+}
+
+// NeedsAlignment returns whether the pod should be aligned to numa nodes under the resource plugin policy.
+func (f *TopologyFitter) NeedsAlignment(pod *v1.Pod) bool {
+	if f.policy == consts.ResourcePluginPolicyNameDynamic {
+		return pod.Annotations[consts.PodAnnotationQoSLevelKey] == consts.PodAnnotationQoSLevelDedicatedCores &&
+			memoryEnhancement(pod)[consts.PodAnnotationMemoryEnhancementNumaBinding] == consts.PodAnnotationMemoryEnhancementNumaBindingEnable
+	}
+
+	if qos.GetPodQOS(pod) != v1.PodQOSGuaranteed {
+		return false
+	}
+	cpu := PodResourceRequests(pod)[v1.ResourceCPU]
+	return cpu.Sign() > 0 && cpu.MilliValue()%1000 == 0
+}
+
+// Fit returns whether the pod fits in the cnr under its TopologyPolicy, and the numa nodes it
+// would be bound to; containers are placed in order for container level policies, and for numeric
+// policies the numa requirement of the pod applies to the pod or each container by the scope.
+func (f *TopologyFitter) Fit(pod *v1.Pod, cnr *nodev1alpha1.CustomNodeResource) *TopologyFitResult {
+	kind, podLevel := parseTopologyPolicy(cnr.Status.TopologyPolicy)
+	if kind == topologyPolicyKindNone || !f.NeedsAlignment(pod) {
+		return &TopologyFitResult{Fit: true}
+	}
+
+	var requirement *NUMARequirement
+	if kind == topologyPolicyKindNumeric {
+		var err error
+		if requirement, err = ParseNUMARequirement(pod.Annotations); err != nil {
+			return &TopologyFitResult{Reason: err.Error()}
+		}
+	}
+
+	numaNodes := f.numaNodes(cnr)
+	exclusive := pod.Annotations[consts.PodAnnotationQoSLevelKey] == consts.PodAnnotationQoSLevelDedicatedCores &&
+		memoryEnhancement(pod)[consts.PodAnnotationMemoryEnhancementNumaExclusive] == consts.PodAnnotationMemoryEnhancementNumaExclusiveEnable
+
+	if podLevel {
+		ids, err := placeOnNUMANodes(kind, numaNodes, f.alignedRequests(PodResourceRequests(pod)), exclusive, requirement)
+		if err != nil {
+			return &TopologyFitResult{Reason: err.Error()}
+		}
+		return &TopologyFitResult{Fit: true, NUMANodes: ids}
+	}
+
+	result := &TopologyFitResult{Fit: true, ContainerNUMANodes: make(map[string][]int)}
+	used := make(map[int]bool)
+	for _, container := range pod.Spec.Containers {
+		requests := f.alignedRequests(container.Resources.Requests)
+		ids, err := placeOnNUMANodes(kind, numaNodes, requests, exclusive, requirement)
+		if err != nil {
+			return &TopologyFitResult{Reason: fmt.Sprintf("container %s: %v", container.Name, err)}
+		}
+		if len(ids) == 0 {
+			continue
+		}
+
+		consumeNUMANodes(numaNodes, ids, requests, exclusive)
+		result.ContainerNUMANodes[container.Name] = ids
+		for _, id := range ids {
+			if !used[id] {
+				used[id] = true
+				result.NUMANodes = append(result.NUMANodes, id)
+			}
+		}
+	}
+	sort.Ints(result.NUMANodes)
+	return result
+}
+
+// Score returns the score in [0, 100] of the fit result by the scoring strategy, which is computed
+// on the numa nodes the pod would be bound to; it's 0 if the pod doesn't fit or needs no alignment.
+func (f *TopologyFitter) Score(pod *v1.Pod, cnr *nodev1alpha1.CustomNodeResource, result *TopologyFitResult) int64 {
+	if result == nil || !result.Fit || len(result.NUMANodes) == 0 {
+		return 0
+	}
+
+	numaNodes := f.numaNodes(cnr)
+	if f.scoringStrategy.Type == consts.LeastNUMANodes {
+		if len(numaNodes) == 0 {
+			return 0
+		}
+		// prefer fewer numa nodes, e.g. 100 for one node out of one and 25 for four out of four
+		unused := len(numaNodes) - len(result.NUMANodes)
+		if unused < 0 {
+			unused = 0
+		}
+		return maxTopologyScore * int64(unused+1) / int64(len(numaNodes))
+	}
+
+	selected := make(map[int]bool, len(result.NUMANodes))
+	for _, id := range result.NUMANodes {
+		selected[id] = true
+	}
+
+	podRequests := PodResourceRequests(pod)
+	var fractions, weights []float64
+	for _, spec := range f.scoringStrategy.Resources {
+		name := v1.ResourceName(spec.Name)
+		var allocatable, requested resource.Quantity
+		for _, numa := range numaNodes {
+			if !selected[numa.id] {
+				continue
+			}
+			allocatable.Add(numa.allocatable[name])
+			requested.Add(numa.allocatable[name])
+			requested.Sub(numa.free[name])
+		}
+		if allocatable.Sign() <= 0 {
+			continue
+		}
+
+		requested.Add(podRequests[name])
+		fraction := float64(requested.MilliValue()) / float64(allocatable.MilliValue())
+		fractions = append(fractions, math.Min(math.Max(fraction, 0), 1))
+		weights = append(weights, float64(spec.Weight))
+	}
+	if len(fractions) == 0 {
+		return 0
+	}
+
+	switch f.scoringStrategy.Type {
+	case kubeschedulerconfig.MostAllocated:
+		return weightedTopologyScore(fractions, weights, func(fraction float64) float64 { return fraction })
+	case consts.BalancedAllocation:
+		return balancedTopologyScore(fractions)
+	case kubeschedulerconfig.RequestedToCapacityRatio:
+		var shape []kubeschedulerconfig.UtilizationShapePoint
+		if f.scoringStrategy.RequestedToCapacityRatio != nil {
+			shape = f.scoringStrategy.RequestedToCapacityRatio.Shape
+		}
+		return weightedTopologyScore(fractions, weights, func(fraction float64) float64 {
+			return shapeTopologyScore(shape, fraction)
+		})
+	default:
+		return weightedTopologyScore(fractions, weights, func(fraction float64) float64 { return 1 - fraction })
+	}
+}
+
+// numaNode is the allocatable and free aligned resources of a numa zone
+type numaNode struct {
+	id          int
+	socket      string
+	allocatable v1.ResourceList
+	free        v1.ResourceList
+	allocated   bool
+}
+
+// numaNodes returns numa nodes in the cnr with the aligned resources
+func (f *TopologyFitter) numaNodes(cnr *nodev1alpha1.CustomNodeResource) []*numaNode {
+	return numaNodesOf(cnr.Status.TopologyZone, f.alignedResources)
+}
+
+// numaNodesOf returns numa nodes in the tree sorted by id with the given resources, and zones with
+// invalid names are ignored; zero quantities are dropped, so a numa node without free resource doesn't
+// satisfy any request of it.
+func numaNodesOf(zones []*nodev1alpha1.TopologyZone, resourceNames []v1.ResourceName) []*numaNode {
+	filter := func(list v1.ResourceList) v1.ResourceList {
+		result := v1.ResourceList{}
+		for _, name := range resourceNames {
+			if quantity, ok := list[name]; ok && !quantity.IsZero() {
+				result[name] = quantity.DeepCopy()
+			}
+		}
+		return result
+	}
+
+	var result []*numaNode
+	_ = topology.Walk(zones, func(zone *nodev1alpha1.TopologyZone, parents []*nodev1alpha1.TopologyZone) error {
+		if zone.Type != nodev1alpha1.TopologyTypeNuma {
+			return nil
+		}
+
+		id, err := strconv.Atoi(zone.Name)
+		if err != nil {
+			return topology.SkipChildren
+		}
+
+		numa := &numaNode{id: id, allocatable: v1.ResourceList{}, free: v1.ResourceList{}}
+		for i := len(parents) - 1; i >= 0; i-- {
+			if parents[i].Type == nodev1alpha1.TopologyTypeSocket {
+				numa.socket = parents[i].Name
+				break
+			}
+		}
+		if zone.Resources.Allocatable != nil {
+			numa.allocatable = filter(*zone.Resources.Allocatable)
+			numa.free = filter(topology.Free(zone))
+		}
+		for _, allocation := range zone.Allocations {
+			if allocation != nil && allocation.Requests != nil && len(*allocation.Requests) > 0 {
+				numa.allocated = true
+			}
+		}
+		result = append(result, numa)
+		return topology.SkipChildren
+	})
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].id < result[j].id
+	})
+	return result
+}
+
+// alignedRequests returns the aligned resources in the list without zero quantities
+func (f *TopologyFitter) alignedRequests(list v1.ResourceList) v1.ResourceList {
+	result := v1.ResourceList{}
+	for _, name := range f.alignedResources {
+		if quantity, ok := list[name]; ok && !quantity.IsZero() {
+			result[name] = quantity.DeepCopy()
+		}
+	}
+	return result
+}
+
+func parseTopologyPolicy(policy nodev1alpha1.TopologyPolicy) (topologyPolicyKind, bool) {
+	switch policy {
+	case nodev1alpha1.TopologyPolicySingleNUMANodePodLevel:
+		return topologyPolicyKindSingleNUMANode, true
+	case nodev1alpha1.TopologyPolicySingleNUMANodeContainerLevel:
+		return topologyPolicyKindSingleNUMANode, false
+	case nodev1alpha1.TopologyPolicyRestrictedPodLevel:
+		return topologyPolicyKindRestricted, true
+	case nodev1alpha1.TopologyPolicyRestrictedContainerLevel:
+		return topologyPolicyKindRestricted, false
+	case nodev1alpha1.TopologyPolicyBestEffortPodLevel:
+		return topologyPolicyKindBestEffort, true
+	case nodev1alpha1.TopologyPolicyBestEffortContainerLevel:
+		return topologyPolicyKindBestEffort, false
+	case nodev1alpha1.TopologyPolicyNumericPodLevel:
+		return topologyPolicyKindNumeric, true
+	case nodev1alpha1.TopologyPolicyNumericContainerLevel:
+		return topologyPolicyKindNumeric, false
+	default:
+		return topologyPolicyKindNone, true
+	}
+}
+
+// placeOnNUMANodes returns the narrowest set of numa nodes whose free resources satisfy the
+// requests; like topology manager, restricted and numeric policies only accept the preferred
+// width, which is the narrowest one by allocatable, while best-effort accepts any result.
+// For numeric policies, the numa requirement of the pod decides the candidates if specified.
+func placeOnNUMANodes(kind topologyPolicyKind, numaNodes []*numaNode, requests v1.ResourceList,
+	exclusive bool, requirement *NUMARequirement,
+) ([]int, error) {
+	if len(requests) == 0 {
+		return nil, nil
+	}
+
+	available := make([]*numaNode, 0, len(numaNodes))
+	for _, numa := range numaNodes {
+		if !exclusive || !numa.allocated {
+			available = append(available, numa)
+		}
+	}
+
+	allocatable := func(numa *numaNode) v1.ResourceList { return numa.allocatable }
+	free := func(numa *numaNode) v1.ResourceList { return numa.free }
+
+	switch kind {
+	case topologyPolicyKindSingleNUMANode:
+		if ids := narrowestNUMANodes(available, requests, free, 1); ids != nil {
+			return ids, nil
+		}
+		return nil, fmt.Errorf("cannot fit in a single numa node")
+	case topologyPolicyKindNumeric:
+		if requirement != nil && !requirement.IsEmpty() {
+			if candidates := numericCandidates(numaNodes, available, requirement, requests); len(candidates) > 0 {
+				return candidates[0], nil
+			}
+			return nil, fmt.Errorf("cannot fit in numa nodes required by %s", requirement)
+		}
+		fallthrough
+	case topologyPolicyKindRestricted:
+		preferred := len(narrowestNUMANodes(numaNodes, requests, allocatable, len(numaNodes)))
+		if preferred == 0 {
+			return nil, fmt.Errorf("requests exceed allocatable of all numa nodes")
+		}
+		if ids := narrowestNUMANodes(available, requests, free, preferred); ids != nil {
+			return ids, nil
+		}
+		return nil, fmt.Errorf("cannot fit in %d numa node(s)", preferred)
+	default:
+		return narrowestNUMANodes(available, requests, free, len(available)), nil
+	}
+}
+
+// narrowestNUMANodes returns ids of the first combination in lexicographical order among the narrowest
+// ones whose resources satisfy the requests, and returns nil if no combination within maxWidth does.
+func narrowestNUMANodes(numaNodes []*numaNode, requests v1.ResourceList,
+	resources func(*numaNode) v1.ResourceList, maxWidth int,
+) []int {
+	if maxWidth > len(numaNodes) {
+		maxWidth = len(numaNodes)
+	}
+
+	for width := 1; width <= maxWidth; width++ {
+		var ids []int
+		forEachCombination(len(numaNodes), width, func(indexes []int) bool {
+			sum := v1.ResourceList{}
+			for _, index := range indexes {
+				addResourceList(sum, resources(numaNodes[index]))
+			}
+			if !satisfiesResources(sum, requests) {
+				return true
+			}
+
+			ids = numaIDs(numaNodes, indexes)
+			return false
+		})
+		if ids != nil {
+			return ids
+		}
+	}
+	return nil
+}
+
+// forEachCombination calls fn with each combination of k indexes in [0, n) in lexicographical
+// order until fn returns false; the indexes slice is reused among calls.
+func forEachCombination(n, k int, fn func(indexes []int) bool) {
+	if k <= 0 || k > n {
+		return
+	}
+
+	indexes := make([]int, k)
+	for i := range indexes {
+		indexes[i] = i
+	}
+
+	for fn(indexes) {
+		i := k - 1
+		for i >= 0 && indexes[i] == n-k+i {
+			i--
+		}
+		if i < 0 {
+			return
+		}
+
+		indexes[i]++
+		for j := i + 1; j < k; j++ {
+			indexes[j] = indexes[j-1] + 1
+		}
+	}
+}
+
+func numaIDs(numaNodes []*numaNode, indexes []int) []int {
+	ids := make([]int, 0, len(indexes))
+	for _, index := range indexes {
+		ids = append(ids, numaNodes[index].id)
+	}
+	return ids
+}
+
+// satisfiesResources returns whether all the requested resources exist in available and are enough
+func satisfiesResources(available, requests v1.ResourceList) bool {
+	return len(insufficientResources(requests, available)) == 0 && coversResources(available, requests)
+}
+
+// coversResources returns whether all the requested resources exist in available
+func coversResources(available, requests v1.ResourceList) bool {
+	for name := range requests {
+		if _, ok := available[name]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// consumeNUMANodes subtracts the requests from the numa nodes in order, and exclusive
+// requests take the whole numa nodes.
+func consumeNUMANodes(numaNodes []*numaNode, ids []int, requests v1.ResourceList, exclusive bool) {
+	remaining := requests.DeepCopy()
+	for _, id := range ids {
+		for _, numa := range numaNodes {
+			if numa.id != id {
+				continue
+			}
+
+			numa.allocated = true
+			for name, free := range numa.free {
+				request := remaining[name]
+				if exclusive || request.Cmp(free) >= 0 {
+					request.Sub(free)
+					numa.free[name] = resource.Quantity{Format: free.Format}
+				} else {
+					free.Sub(request)
+					numa.free[name] = free
+					request = resource.Quantity{Format: request.Format}
+				}
+				remaining[name] = nonNegative(request)
+			}
+		}
+	}
+}
+
+func memoryEnhancement(pod *v1.Pod) map[string]string {
+	enhancement := make(map[string]string)
+	if value, ok := pod.Annotations[consts.PodAnnotationMemoryEnhancementKey]; ok {
+		_ = json.Unmarshal([]byte(value), &enhancement)
+	}
+	return enhancement
+}
+
+func weightedTopologyScore(fractions, weights []float64, score func(float64) float64) int64 {
+	var sum, weightSum float64
+	for i := range fractions {
+		sum += score(fractions[i]) * float64(maxTopologyScore) * weights[i]
+		weightSum += weights[i]
+	}
+	if weightSum == 0 {
+		return 0
+	}
+	return int64(math.Round(sum / weightSum))
+}
+
+// balancedTopologyScore favors numa nodes whose resource fractions are close to each other
+func balancedTopologyScore(fractions []float64) int64 {
+	var mean float64
+	for _, fraction := range fractions {
+		mean += fraction
+	}
+	mean /= float64(len(fractions))
+
+	var variance float64
+	for _, fraction := range fractions {
+		variance += (fraction - mean) * (fraction - mean)
+	}
+	std := math.Sqrt(variance / float64(len(fractions)))
+	return int64(math.Round((1 - std) * float64(maxTopologyScore)))
+}
+
+// shapeTopologyScore returns the score in [0, 1] of the utilization by the piecewise linear shape,
+// whose utilization ranges in [0, 100] and score ranges in [0, 10].
+func shapeTopologyScore(shape []kubeschedulerconfig.UtilizationShapePoint, fraction float64) float64 {
+	if len(shape) == 0 {
+		return 1 - fraction
+	}
+
+	utilization := fraction * 100
+	if utilization <= float64(shape[0].Utilization) {
+		return float64(shape[0].Score) / float64(maxCustomTopologyScore)
+	}
+	for i := 1; i < len(shape); i++ {
+		if utilization <= float64(shape[i].Utilization) {
+			prev, next := shape[i-1], shape[i]
+			ratio := (utilization - float64(prev.Utilization)) / float64(next.Utilization-prev.Utilization)
+			score := float64(prev.Score) + ratio*float64(next.Score-prev.Score)
+			return score / float64(maxCustomTopologyScore)
+		}
+	}
+	return float64(shape[len(shape)-1].Score) / float64(maxCustomTopologyScore)
+}